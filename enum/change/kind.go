@@ -0,0 +1,38 @@
+// Package change provides access to the Kind enumeration.
+package change
+
+// Kind indicates what aspect of an observed value has changed.
+//
+// See Kind, Value, Breach, and Boundaries.
+type Kind byte
+
+const (
+	// Value indicates that the observed value now holds something different.
+	//
+	// See Kind, Value, Breach, and Boundaries.
+	Value Kind = iota
+
+	// Breach indicates that an operation carried the observed value beyond its boundaries.
+	//
+	// See Kind, Value, Breach, and Boundaries.
+	Breach
+
+	// Boundaries indicates that the observed value's boundaries have been moved.
+	//
+	// See Kind, Value, Breach, and Boundaries.
+	Boundaries
+)
+
+// String prints an uppercase one-word representation of the Kind.
+func (k Kind) String() string {
+	switch k {
+	case Value:
+		return "Value"
+	case Breach:
+		return "Breach"
+	case Boundaries:
+		return "Boundaries"
+	default:
+		return "Unknown"
+	}
+}
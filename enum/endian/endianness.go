@@ -1,6 +1,8 @@
 // Package endianness provides access to the Endianness enumeration.
 package endian
 
+import "fmt"
+
 // Endianness indicates the logical -byte- ordering of sequential bytes.  All binary data has a most significant side,
 // where the binary placeholder has the highest relative value, as well as a least significant side.  The individual BITS
 // of a byte are colloquially manipulated in most→to→least significant order, but multiple BYTES worth of information may
@@ -27,22 +29,35 @@ package endian
 // bit storage order. Because of this, when reading raw memory, only byte ordering needs to be handled explicitly.
 //
 // NOTE: Some protocols, like UART and SPI, traditionally transmit in -BITWISE- little endian order, so you may also need to reverse
-// bits within bytes when interfacing with such protocols!
+// bits within bytes when interfacing with such protocols!  For those cases, there's a third form -
+//
+// BitwiseLittle, where the least significant bytes come first AND each byte's bits are in least←to←most order:
+//
+//	| Least Sig. Byte |   Middle Byte   |  Most Sig. Byte |
+//	| 0 1 1 0 1 0 0 0 | 0 0 1 1 0 1 0 0 | 1 0 1 1 0 0 1 0 | (5,057,558)
+//	|        68       |        34       |        B2       |
+//	         ⬑  The entire bitstream is the mirror image of its Big form
 //
-// See Endianness, Little, and Big.
+// See Endianness, Little, Big, and BitwiseLittle.
 type Endianness byte
 
 const (
 	// Little indicates that bytes are handled in least←to←most significant order and is used by x86, AMD64, ARM, and the general
 	// world over.
 	//
-	// See Endianness, Little, and Big.
+	// See Endianness, Little, Big, and BitwiseLittle.
 	Little Endianness = iota
 
 	// Big indicates that bytes are handled in most→to→least significant order and represents "raw" binary - it's often favored by network protocols.
 	//
-	// See Endianness, Little, and Big.
+	// See Endianness, Little, Big, and BitwiseLittle.
 	Big
+
+	// BitwiseLittle indicates that bytes are handled in least←to←most significant order AND that each byte's bits are also
+	// handled in least←to←most significant order - as traditionally transmitted by serial protocols like UART and SPI.
+	//
+	// See Endianness, Little, Big, and BitwiseLittle.
+	BitwiseLittle
 )
 
 // String prints an uppercase one-word representation of the Endianness.
@@ -52,6 +67,8 @@ func (e Endianness) String() string {
 		return "LittleEndian"
 	case Big:
 		return "BigEndian"
+	case BitwiseLittle:
+		return "BitwiseLittleEndian"
 	default:
 		return "Unknown"
 	}
@@ -73,6 +90,11 @@ func (e Endianness) StringFull(lowercase ...bool) string {
 			return "big endian"
 		}
 		return "Big Endian"
+	case BitwiseLittle:
+		if lower {
+			return "bitwise little endian"
+		}
+		return "Bitwise Little Endian"
 	default:
 		if lower {
 			return "unknown"
@@ -80,3 +102,13 @@ func (e Endianness) StringFull(lowercase ...bool) string {
 		return "Unknown"
 	}
 }
+
+// Parse returns the Endianness represented by the provided string, which may be in either String or StringFull form.
+func Parse(s string) (Endianness, error) {
+	for _, e := range []Endianness{Little, Big, BitwiseLittle} {
+		if s == e.String() || s == e.StringFull() || s == e.StringFull(true) {
+			return e, nil
+		}
+	}
+	return Big, fmt.Errorf("unknown endianness '%s'", s)
+}
@@ -0,0 +1,88 @@
+// Package overflow provides access to the Policy enumeration.
+package overflow
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Policy indicates how a bounded value should react when an operation carries it beyond its boundaries.
+//
+// Given the closed set [0, 7], setting the value 9 yields -
+//
+//	   Wrap → 1  (9 mod 8)
+//	  Clamp → 7  (saturated at the boundary)
+//	Reflect → 5  (bounced two steps back off of 7)
+//	  Panic → ☠  (the operation panics)
+//	 Report → 9  (the value is set as-is)
+//
+// Every policy still reports the Breach - the amount the value overflowed or underflowed its boundaries.
+//
+// See Policy, Unset, Wrap, Clamp, Reflect, Panic, and Report.
+type Policy byte
+
+const (
+	// Unset indicates that no policy was chosen, deferring to the legacy 'Clamp' flag of a bounded value - which
+	// clamps if set, or otherwise wraps.
+	//
+	// NOTE: This is the zero value, allowing an explicit Wrap to be told apart from an unconfigured policy.
+	//
+	// See Policy, Unset, Wrap, Clamp, Reflect, Panic, and Report.
+	Unset Policy = iota
+
+	// Wrap indicates that values overflow and underflow into the opposite side of the boundaries, like modular arithmetic.
+	//
+	// See Policy, Unset, Wrap, Clamp, Reflect, Panic, and Report.
+	Wrap
+
+	// Clamp indicates that values saturate at the boundary they breached.
+	//
+	// See Policy, Unset, Wrap, Clamp, Reflect, Panic, and Report.
+	Clamp
+
+	// Reflect indicates that values 'bounce' off of the boundary they breached, travelling back by the amount they breached
+	// it.  Repeated increments will ping-pong between the boundaries.
+	//
+	// See Policy, Unset, Wrap, Clamp, Reflect, Panic, and Report.
+	Reflect
+
+	// Panic indicates that breaching the boundaries is a programming error, and should panic.
+	//
+	// See Policy, Unset, Wrap, Clamp, Reflect, Panic, and Report.
+	Panic
+
+	// Report indicates that values are set as-is, even beyond the boundaries, while still reporting the breach.
+	//
+	// See Policy, Unset, Wrap, Clamp, Reflect, Panic, and Report.
+	Report
+)
+
+// String prints an uppercase one-word representation of the Policy.
+func (p Policy) String() string {
+	switch p {
+	case Unset:
+		return "Unset"
+	case Wrap:
+		return "Wrap"
+	case Clamp:
+		return "Clamp"
+	case Reflect:
+		return "Reflect"
+	case Panic:
+		return "Panic"
+	case Report:
+		return "Report"
+	default:
+		return "Unknown"
+	}
+}
+
+// Parse returns the Policy represented by the provided string, in any letter case.
+func Parse(s string) (Policy, error) {
+	for _, p := range []Policy{Unset, Wrap, Clamp, Reflect, Panic, Report} {
+		if strings.EqualFold(s, p.String()) {
+			return p, nil
+		}
+	}
+	return Unset, fmt.Errorf("unknown overflow policy '%s'", s)
+}
@@ -0,0 +1,61 @@
+// Package sign provides access to the Style enumeration.
+package sign
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Style indicates how the sign of a printed number should be written.
+//
+// Given the values 1234 and -1234 -
+//
+//	  Negative → "1234"  and "-1234"
+//	  Explicit → "+1234" and "-1234"
+//	Accounting → "1234"  and "(1234)"
+//
+// See Style, Negative, Explicit, and Accounting.
+type Style byte
+
+const (
+	// Negative indicates that only negative values are prefixed with a sign.
+	//
+	// NOTE: This is the zero value, matching the standard see.PrintingNumbers form.
+	//
+	// See Style, Negative, Explicit, and Accounting.
+	Negative Style = iota
+
+	// Explicit indicates that every non-zero value is prefixed with either a '+' or a '-' sign.
+	//
+	// See Style, Negative, Explicit, and Accounting.
+	Explicit
+
+	// Accounting indicates that negative values are wrapped in parentheses, while positive values are left unsigned.
+	//
+	// See Style, Negative, Explicit, and Accounting.
+	Accounting
+)
+
+// String prints an uppercase one-word representation of the Style.
+func (s Style) String() string {
+	switch s {
+	case Negative:
+		return "Negative"
+	case Explicit:
+		return "Explicit"
+	case Accounting:
+		return "Accounting"
+	default:
+		return "Unknown"
+	}
+}
+
+// Parse returns the Style represented by the provided string, in any letter case.
+func Parse(s string) (Style, error) {
+	for _, style := range []Style{Negative, Explicit, Accounting} {
+		if strings.EqualFold(s, style.String()) {
+			return style, nil
+		}
+	}
+	return Negative, fmt.Errorf("unknown sign style '%s'", s)
+}
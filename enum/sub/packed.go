@@ -0,0 +1,252 @@
+package sub
+
+import (
+	"core/sys/num"
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+// A Width identifies the element width of a Packed slice through its SubByte maximum.
+//
+// See Bit, Crumb, Note, Nibble, Flake, Morsel, Shred, Byte, Run, Scale, Riff, Hook, and Packed
+type Width interface {
+	Max() SubByte
+}
+
+// Bit identifies a Packed slice of 1-bit elements.
+type Bit struct{}
+
+// Crumb identifies a Packed slice of 2-bit elements.
+type Crumb struct{}
+
+// Note identifies a Packed slice of 3-bit elements.
+type Note struct{}
+
+// Nibble identifies a Packed slice of 4-bit elements.
+type Nibble struct{}
+
+// Flake identifies a Packed slice of 5-bit elements.
+type Flake struct{}
+
+// Morsel identifies a Packed slice of 6-bit elements.
+type Morsel struct{}
+
+// Shred identifies a Packed slice of 7-bit elements.
+type Shred struct{}
+
+// Byte identifies a Packed slice of 8-bit elements.
+type Byte struct{}
+
+// Run identifies a Packed slice of 10-bit elements.
+type Run struct{}
+
+// Scale identifies a Packed slice of 12-bit elements.
+type Scale struct{}
+
+// Riff identifies a Packed slice of 24-bit elements.
+type Riff struct{}
+
+// Hook identifies a Packed slice of 48-bit elements.
+type Hook struct{}
+
+func (Bit) Max() SubByte    { return BitMax }
+func (Crumb) Max() SubByte  { return CrumbMax }
+func (Note) Max() SubByte   { return NoteMax }
+func (Nibble) Max() SubByte { return NibbleMax }
+func (Flake) Max() SubByte  { return FlakeMax }
+func (Morsel) Max() SubByte { return MorselMax }
+func (Shred) Max() SubByte  { return ShredMax }
+func (Byte) Max() SubByte   { return ByteMax }
+func (Run) Max() SubByte    { return RunMax }
+func (Scale) Max() SubByte  { return ScaleMax }
+func (Riff) Max() SubByte   { return RiffMax }
+func (Hook) Max() SubByte   { return HookMax }
+
+// Packed is a densely stored slice of SubByte values, each occupying exactly the bit width of W.  A million Note
+// values, for instance, occupy 375,000 bytes - rather than a million Numeric[uint] structures.
+//
+//	p := sub.NewPacked[sub.Note](5, 3, 7)  // 101 011 111
+//
+// Values written beyond the width's maximum overflow and underflow just like a Numeric[uint] - unless Clamp is true,
+// in which case they're clamped to the closed set [0, W.Max()].
+//
+// NOTE: Like a slice, copies of a Packed share their underlying storage until appended to.
+//
+// See NewPacked, NewPackedOf, Packed.Get, Packed.Set, Packed.Append, and Packed.All
+type Packed[W Width] struct {
+	measurement num.Measurement
+	length      int
+	Clamp       bool
+}
+
+// NewPacked creates a Packed slice of the provided values.
+func NewPacked[W Width](values ...uint) Packed[W] {
+	p := Packed[W]{measurement: num.NewMeasurement()}
+	p.Append(values...)
+	return p
+}
+
+// NewPackedOf creates a Packed slice from the bits of the provided measurement, which are copied.
+//
+// NOTE: This will panic if the measurement's width is not a multiple of W's width.
+func NewPackedOf[W Width](m num.Measurement) Packed[W] {
+	width := widthOf[W]()
+	if m.BitWidth()%width != 0 {
+		panic(fmt.Sprintf("cannot pack a %d-bit measurement into %d-bit elements", m.BitWidth(), width))
+	}
+
+	return Packed[W]{
+		measurement: num.NewMeasurement(m.GetAllBits()...),
+		length:      int(m.BitWidth() / width),
+	}
+}
+
+// Len returns the number of elements in the slice.
+func (p Packed[W]) Len() int {
+	return p.length
+}
+
+// Width returns the bit width of each element.
+func (p Packed[W]) Width() uint {
+	return widthOf[W]()
+}
+
+// Max returns the maximum value of each element.
+func (p Packed[W]) Max() uint {
+	var w W
+	return uint(w.Max())
+}
+
+// Get returns the value at the provided index.
+//
+// NOTE: This will panic if the index is out of range.
+func (p Packed[W]) Get(i int) uint {
+	p.sanityCheck(i)
+
+	width := int(p.Width())
+	var out uint
+	for b := i * width; b < (i+1)*width; b++ {
+		out = out<<1 | uint(p.bit(b))
+	}
+	return out
+}
+
+// Set bounds the provided value to the element width and writes it at the provided index.  The amount that the value
+// over or underflowed is returned as a Breach string, which is empty if the value did not breach the boundaries.
+//
+// NOTE: This will panic if the index is out of range.
+func (p *Packed[W]) Set(i int, value uint) num.Breach {
+	p.sanityCheck(i)
+
+	bounded, breach := p.bound(value)
+	width := int(p.Width())
+	for b := 0; b < width; b++ {
+		p.setBit(i*width+b, num.Bit((bounded>>(width-1-b))&1))
+	}
+	return breach
+}
+
+// Append bounds the provided values to the element width and places them at the end of the slice.  The returned
+// Breach is that of the last value to breach the boundaries, or empty if none did.
+func (p *Packed[W]) Append(values ...uint) num.Breach {
+	width := int(p.Width())
+
+	var breach num.Breach
+	elements := make([]num.Bit, 0, len(values)*width)
+	for _, v := range values {
+		bounded, b := p.bound(v)
+		if b != "" {
+			breach = b
+		}
+		for i := width - 1; i >= 0; i-- {
+			elements = append(elements, num.Bit((bounded>>i)&1))
+		}
+	}
+
+	p.measurement = p.measurement.Append(elements...)
+	p.length += len(values)
+	return breach
+}
+
+// All returns an iterator over the index and value of every element.
+func (p Packed[W]) All() iter.Seq2[int, uint] {
+	return func(yield func(int, uint) bool) {
+		for i := 0; i < p.length; i++ {
+			if !yield(i, p.Get(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns the elements as an unpacked slice.
+func (p Packed[W]) Values() []uint {
+	out := make([]uint, p.length)
+	for i := range out {
+		out[i] = p.Get(i)
+	}
+	return out
+}
+
+// Measurement returns a copy of the packed bits as a single measurement.
+func (p Packed[W]) Measurement() num.Measurement {
+	return num.NewMeasurement(p.measurement.GetAllBits()...)
+}
+
+// String returns the elements as space separated binary strings of the element width.
+func (p Packed[W]) String() string {
+	width := p.Width()
+	out := make([]string, p.length)
+	for i, v := range p.All() {
+		out[i] = fmt.Sprintf("%0*b", width, v)
+	}
+	return strings.Join(out, " ")
+}
+
+func (p Packed[W]) sanityCheck(i int) {
+	if i < 0 || i >= p.length {
+		panic(fmt.Sprintf("index %d out of range [0, %d)", i, p.length))
+	}
+}
+
+// bound wraps or clamps the value into the element width, exactly as a bounded num.Numeric[uint] would.
+func (p Packed[W]) bound(value uint) (uint, num.Breach) {
+	maximum := p.Max()
+	if value <= maximum {
+		return value, ""
+	}
+
+	breach := num.Breach(num.ToString(value - maximum))
+	if p.Clamp {
+		return maximum, breach
+	}
+	return value % (maximum + 1), breach
+}
+
+// bit reads the bit at the provided absolute index - which lives in the rolled up bytes, or the trailing bits.
+func (p Packed[W]) bit(i int) num.Bit {
+	if i < len(p.measurement.Bytes)*8 {
+		return num.Bit((p.measurement.Bytes[i/8] >> (7 - i%8)) & 1)
+	}
+	return p.measurement.Bits[i-len(p.measurement.Bytes)*8]
+}
+
+func (p *Packed[W]) setBit(i int, b num.Bit) {
+	if i < len(p.measurement.Bytes)*8 {
+		mask := byte(1) << (7 - i%8)
+		if b == 1 {
+			p.measurement.Bytes[i/8] |= mask
+		} else {
+			p.measurement.Bytes[i/8] &^= mask
+		}
+		return
+	}
+	p.measurement.Bits[i-len(p.measurement.Bytes)*8] = b
+}
+
+func widthOf[W Width]() uint {
+	var w W
+	return uint(bits.Len(uint(w.Max())))
+}
@@ -0,0 +1,162 @@
+package sub
+
+import "core/sys/num"
+
+// A SignedSubByte is an implied two's complement integer of a non-standard bit width - the signed counterpart of a SubByte.
+//
+// "The Extended Signed Integer Types"
+//
+//	  Name | Width | Closed Interval
+//	 Crumb |    2  | [-2¹, 2¹ - 1]   (-2, 1)
+//	  Note |    3  | [-2², 2² - 1]   (-4, 3)
+//	Nibble |    4  | [-2³, 2³ - 1]   (-8, 7)
+//	 Flake |    5  | [-2⁴, 2⁴ - 1]   (-16, 15)
+//	Morsel |    6  | [-2⁵, 2⁵ - 1]   (-32, 31)
+//	 Shred |    7  | [-2⁶, 2⁶ - 1]   (-64, 63)
+//	  Byte |    8  | [-2⁷, 2⁷ - 1]   (-128, 127)
+//	   Run |   10  | [-2⁹, 2⁹ - 1]   (-512, 511)
+//	 Scale |   12  | [-2¹¹, 2¹¹ - 1] (-2,048, 2,047)
+//	  Riff |   24  | [-2²³, 2²³ - 1] (-8,388,608, 8,388,607)
+//	  Hook |   48  | [-2⁴⁷, 2⁴⁷ - 1] (-140,737,488,355,328, 140,737,488,355,327)
+//
+// NOTE: A signed Bit would only hold [-1, 0], and is intentionally omitted.
+//
+// See NewSignedCrumb, NewSignedNote, NewSignedNibble, NewSignedFlake, NewSignedMorsel, NewSignedShred,
+// NewSignedByte, NewSignedRun, NewSignedScale, NewSignedRiff, and NewSignedHook
+type SignedSubByte int
+
+// The closed intervals of the extended signed integer types - see SignedSubByte
+const (
+	SignedCrumbMin  SignedSubByte = -1 << 1
+	SignedCrumbMax  SignedSubByte = 1<<1 - 1
+	SignedNoteMin   SignedSubByte = -1 << 2
+	SignedNoteMax   SignedSubByte = 1<<2 - 1
+	SignedNibbleMin SignedSubByte = -1 << 3
+	SignedNibbleMax SignedSubByte = 1<<3 - 1
+	SignedFlakeMin  SignedSubByte = -1 << 4
+	SignedFlakeMax  SignedSubByte = 1<<4 - 1
+	SignedMorselMin SignedSubByte = -1 << 5
+	SignedMorselMax SignedSubByte = 1<<5 - 1
+	SignedShredMin  SignedSubByte = -1 << 6
+	SignedShredMax  SignedSubByte = 1<<6 - 1
+	SignedByteMin   SignedSubByte = -1 << 7
+	SignedByteMax   SignedSubByte = 1<<7 - 1
+	SignedRunMin    SignedSubByte = -1 << 9
+	SignedRunMax    SignedSubByte = 1<<9 - 1
+	SignedScaleMin  SignedSubByte = -1 << 11
+	SignedScaleMax  SignedSubByte = 1<<11 - 1
+	SignedRiffMin   SignedSubByte = -1 << 23
+	SignedRiffMax   SignedSubByte = 1<<23 - 1
+	SignedHookMin   SignedSubByte = -1 << 47
+	SignedHookMax   SignedSubByte = 1<<47 - 1
+)
+
+// NewSignedCrumb returns a num.Numeric[int] bounded in the closed interval [-2¹, 2¹ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedCrumb(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedCrumbMin, SignedCrumbMax, value...)
+}
+
+// NewSignedNote returns a num.Numeric[int] bounded in the closed interval [-2², 2² - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedNote(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedNoteMin, SignedNoteMax, value...)
+}
+
+// NewSignedNibble returns a num.Numeric[int] bounded in the closed interval [-2³, 2³ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedNibble(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedNibbleMin, SignedNibbleMax, value...)
+}
+
+// NewSignedFlake returns a num.Numeric[int] bounded in the closed interval [-2⁴, 2⁴ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedFlake(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedFlakeMin, SignedFlakeMax, value...)
+}
+
+// NewSignedMorsel returns a num.Numeric[int] bounded in the closed interval [-2⁵, 2⁵ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedMorsel(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedMorselMin, SignedMorselMax, value...)
+}
+
+// NewSignedShred returns a num.Numeric[int] bounded in the closed interval [-2⁶, 2⁶ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedShred(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedShredMin, SignedShredMax, value...)
+}
+
+// NewSignedByte returns a num.Numeric[int] bounded in the closed interval [-2⁷, 2⁷ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedByte(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedByteMin, SignedByteMax, value...)
+}
+
+// NewSignedRun returns a num.Numeric[int] bounded in the closed interval [-2⁹, 2⁹ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedRun(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedRunMin, SignedRunMax, value...)
+}
+
+// NewSignedScale returns a num.Numeric[int] bounded in the closed interval [-2¹¹, 2¹¹ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedScale(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedScaleMin, SignedScaleMax, value...)
+}
+
+// NewSignedRiff returns a num.Numeric[int] bounded in the closed interval [-2²³, 2²³ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedRiff(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedRiffMin, SignedRiffMax, value...)
+}
+
+// NewSignedHook returns a num.Numeric[int] bounded in the closed interval [-2⁴⁷, 2⁴⁷ - 1]
+//
+// NOTE: If no value is provided, this returns a random value in the bounded range.
+//
+// See SignedSubByte
+func NewSignedHook(value ...int) num.Numeric[int] {
+	return newSignedSubByte(SignedHookMin, SignedHookMax, value...)
+}
+
+func newSignedSubByte(minimum, maximum SignedSubByte, value ...int) num.Numeric[int] {
+	var v int
+	if len(value) > 0 {
+		v = value[0]
+	} else {
+		v = num.RandomWithinRange[int](int(minimum), int(maximum))
+	}
+	n, _ := num.NewNumericBounded[int](v, int(minimum), int(maximum))
+	return n
+}
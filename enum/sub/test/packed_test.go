@@ -0,0 +1,69 @@
+package test
+
+import (
+	"core/enum/sub"
+	"core/std"
+	"core/sys/num"
+	"testing"
+)
+
+func Test_Packed_GetSet(t *testing.T) {
+	p := sub.NewPacked[sub.Note](5, 3, 7)
+	if p.String() != "101 011 111" || p.Measurement().BitWidth() != 9 {
+		t.Fatalf("NewPacked[Note](5, 3, 7) = %v", p)
+	}
+
+	if breach := p.Set(1, 9); p.Get(1) != 1 || breach == "" {
+		t.Errorf("wrapping Set(1, 9) = %v (%v), want 1", p.Get(1), breach)
+	}
+
+	p.Clamp = true
+	if breach := p.Set(2, 12); p.Get(2) != 7 || breach == "" {
+		t.Errorf("clamping Set(2, 12) = %v (%v), want 7", p.Get(2), breach)
+	}
+	if p.Get(0) != 5 {
+		t.Errorf("neighbouring element was disturbed - got %v, want 5", p.Get(0))
+	}
+}
+
+func Test_Packed_AppendAndIterate(t *testing.T) {
+	p := sub.NewPacked[sub.Scale]()
+	for i := uint(0); i < 100; i++ {
+		p.Append(i * 40)
+	}
+
+	if p.Len() != 100 || p.Measurement().BitWidth() != 1200 {
+		t.Fatalf("expected 100 elements in 1200 bits, got %d in %d", p.Len(), p.Measurement().BitWidth())
+	}
+	for i, v := range p.All() {
+		if v != uint(i)*40 {
+			t.Fatalf("element %d = %v, want %v", i, v, i*40)
+		}
+	}
+}
+
+func Test_Packed_Phrase(t *testing.T) {
+	p := sub.NewPacked[sub.Flake](1, 2, 30, 31)
+	phrase := std.NewPhraseOfPacked(p)
+
+	back := std.ToPacked[sub.Flake](phrase)
+	for i, v := range p.All() {
+		if back.Get(i) != v {
+			t.Errorf("element %d = %v, want %v", i, back.Get(i), v)
+		}
+	}
+}
+
+func Test_Packed_Bound_MatchesNumeric(t *testing.T) {
+	for _, clamp := range []bool{false, true} {
+		p := sub.NewPacked[sub.Note](1)
+		p.Clamp = clamp
+		for value := uint(0); value < 40; value++ {
+			breach := p.Set(0, value)
+			n, expected := num.NewNumericBounded[uint](value, 0, p.Max(), clamp)
+			if p.Get(0) != n.Value() || breach != expected {
+				t.Errorf("clamp %v: Set(%d) = %d (%q), want %d (%q)", clamp, value, p.Get(0), breach, n.Value(), expected)
+			}
+		}
+	}
+}
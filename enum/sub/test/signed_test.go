@@ -0,0 +1,13 @@
+package test
+
+import (
+	"core/enum/sub"
+	"testing"
+)
+
+func Test_SignedSubByte(t *testing.T) {
+	note := sub.NewSignedNote(3)
+	if breach := note.Set(4); note.Value() != -4 || breach == "" {
+		t.Errorf("SignedNote(4) = %v, want -4", note.Value())
+	}
+}
@@ -223,6 +223,40 @@ func (a Phrase) Reverse() Phrase {
 	return a
 }
 
+// Transpose treats the phrase's measurements as the rows of a bit matrix and returns a phrase of its columns -
+// where the 𝑛th resulting measurement holds the 𝑛th bit of every source measurement, in order.
+//
+//	| 1 1 0 - 0 1 1 |  ← Phrase
+//	| 1 0 - 1 1 - 0 1 |  ← Transposed
+//
+// NOTE: Every measurement must be of the same width - if you'd like to transpose uneven data, please Align it first.
+func (a Phrase) Transpose() Phrase {
+	if len(a.Data) == 0 {
+		return a
+	}
+
+	rows := make([][]num.Bit, len(a.Data))
+	width := a.Data[0].BitWidth()
+	for i, m := range a.Data {
+		if m.BitWidth() != width {
+			panic("cannot transpose a phrase of uneven measurement widths")
+		}
+		rows[i] = m.GetAllBits()
+	}
+
+	columns := make([]num.Measurement, width)
+	for c := range columns {
+		column := make([]num.Bit, len(rows))
+		for r := range rows {
+			column[r] = rows[r][c]
+		}
+		columns[c] = num.NewMeasurement(column...)
+	}
+
+	a.Data = columns
+	return a
+}
+
 // String returns a string consisting entirely of 1s and 0s.
 func (a Phrase) String() string {
 	builder := strings.Builder{}
@@ -0,0 +1,196 @@
+package std
+
+import (
+	"core/enum/endian"
+	"core/sys/num"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+/**
+Binary
+*/
+
+// MarshalBinary encodes the phrase's name and measurements in a compact binary form, satisfying encoding.BinaryMarshaler -
+//
+//	| name length (uvarint) | name | measurement count (uvarint) | [ length (uvarint) | num.Measurement.MarshalBinary ]… |
+func (a Phrase) MarshalBinary() ([]byte, error) {
+	out := binary.AppendUvarint(nil, uint64(len(a.Name.Name)))
+	out = append(out, a.Name.Name...)
+	out = binary.AppendUvarint(out, uint64(len(a.Data)))
+
+	for _, m := range a.Data {
+		encoded, err := m.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		out = binary.AppendUvarint(out, uint64(len(encoded)))
+		out = append(out, encoded...)
+	}
+	return out, nil
+}
+
+// UnmarshalBinary decodes the output of MarshalBinary, satisfying encoding.BinaryUnmarshaler.
+//
+// NOTE: The decoded phrase is a new Entity - it retains the encoded name, but is given a new identifier.
+func (a *Phrase) UnmarshalBinary(data []byte) error {
+	readChunk := func() ([]byte, error) {
+		length, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < length {
+			return nil, errors.New("truncated phrase data")
+		}
+		chunk := data[n : n+int(length)]
+		data = data[n+int(length):]
+		return chunk, nil
+	}
+
+	name, err := readChunk()
+	if err != nil {
+		return err
+	}
+
+	count, n := binary.Uvarint(data)
+	if n <= 0 {
+		return errors.New("invalid phrase measurement count")
+	}
+	data = data[n:]
+
+	// NOTE: Every measurement takes at least one byte, so the remaining data caps how many could possibly follow
+	measurements := make([]num.Measurement, 0, min(count, uint64(len(data))))
+	for i := uint64(0); i < count; i++ {
+		chunk, err := readChunk()
+		if err != nil {
+			return err
+		}
+
+		var m num.Measurement
+		if err = m.UnmarshalBinary(chunk); err != nil {
+			return fmt.Errorf("measurement %d: %w", i, err)
+		}
+		measurements = append(measurements, m)
+	}
+	if len(data) > 0 {
+		return fmt.Errorf("%d unexpected trailing bytes after phrase data", len(data))
+	}
+
+	*a = newPhraseNamedOrRandom(string(name), measurements...)
+	return nil
+}
+
+/**
+Text
+*/
+
+// MarshalText encodes the phrase using its StringPretty syntax, preceded by the phrase's name, satisfying encoding.TextMarshaler.
+// If any measurement isn't in standard endian.Big form, the endianness of every measurement follows the closing pipe -
+// just as with num.Measurement.MarshalText -
+//
+//	Alex | 0 1 - 0 1 0 - 0 1 1 0 1 0 0 0 |
+//	Mixed | 0 0 1 0 1 0 1 0 0 0 0 0 0 0 0 0 - 1 | LittleEndian BigEndian
+func (a Phrase) MarshalText() ([]byte, error) {
+	builder := strings.Builder{}
+	if len(a.Name.Name) > 0 {
+		builder.WriteString(a.Name.Name + " ")
+	}
+	builder.WriteString(strings.TrimSpace(a.StringPretty()))
+
+	for _, m := range a.Data {
+		if m.Endianness != endian.Big {
+			for _, e := range a.Data {
+				builder.WriteString(" ")
+				builder.WriteString(e.Endianness.String())
+			}
+			break
+		}
+	}
+	return []byte(builder.String()), nil
+}
+
+// UnmarshalText decodes the output of MarshalText, satisfying encoding.TextUnmarshaler.
+//
+// NOTE: The decoded phrase is a new Entity - it retains the encoded name, but is given a new identifier.
+func (a *Phrase) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+
+	opening := strings.Index(s, "|")
+	closing := strings.LastIndex(s, "|")
+	if opening < 0 || closing == opening {
+		return fmt.Errorf("phrase text must be wrapped in pipes: %q", s)
+	}
+
+	name := strings.TrimSpace(s[:opening])
+	inner := strings.TrimSpace(s[opening+1 : closing])
+
+	var measurements []num.Measurement
+	if len(inner) > 0 {
+		for i, segment := range strings.Split(inner, "-") {
+			m, err := num.ParseMeasurement(segment)
+			if err != nil {
+				return fmt.Errorf("measurement %d: %w", i, err)
+			}
+			measurements = append(measurements, m)
+		}
+	}
+
+	if suffix := strings.Fields(s[closing+1:]); len(suffix) > 0 {
+		if len(suffix) != len(measurements) {
+			return fmt.Errorf("expected %d endianness values after phrase data, got %q", len(measurements), s[closing+1:])
+		}
+		for i, name := range suffix {
+			e, err := endian.Parse(name)
+			if err != nil {
+				return fmt.Errorf("measurement %d: %w", i, err)
+			}
+			measurements[i].Endianness = e
+		}
+	}
+
+	*a = newPhraseNamedOrRandom(name, measurements...)
+	return nil
+}
+
+/**
+JSON
+*/
+
+type phraseJSON struct {
+	Name string            `json:"name"`
+	Data []num.Measurement `json:"data"`
+}
+
+// MarshalJSON encodes the phrase as a JSON object holding its name and measurements.
+//
+//	{"name":"Alex","data":[{"endianness":"BigEndian","bits":"01"},{"endianness":"BigEndian","bits":"010"}]}
+func (a Phrase) MarshalJSON() ([]byte, error) {
+	data := a.Data
+	if data == nil {
+		data = []num.Measurement{}
+	}
+	return json.Marshal(phraseJSON{
+		Name: a.Name.Name,
+		Data: data,
+	})
+}
+
+// UnmarshalJSON decodes the output of MarshalJSON.
+//
+// NOTE: The decoded phrase is a new Entity - it retains the encoded name, but is given a new identifier.
+func (a *Phrase) UnmarshalJSON(data []byte) error {
+	var raw phraseJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*a = newPhraseNamedOrRandom(raw.Name, raw.Data...)
+	return nil
+}
+
+// newPhraseNamedOrRandom creates a named phrase, or a randomly named phrase if no name is provided.
+func newPhraseNamedOrRandom(name string, m ...num.Measurement) Phrase {
+	if len(name) == 0 {
+		return NewPhrase(m...)
+	}
+	return NewPhraseNamed(name, m...)
+}
@@ -0,0 +1,25 @@
+package std
+
+import (
+	"core/enum/sub"
+	"core/sys/num"
+)
+
+// NewPhraseOfPacked creates a phrase holding the densely packed bits of the provided slice as a single measurement.
+//
+// NOTE: To hold each element in its own measurement, call Phrase.Align with the slice's element width.
+//
+// See NewPhraseOfPacked and ToPacked
+func NewPhraseOfPacked[W sub.Width](p sub.Packed[W]) Phrase {
+	return NewPhrase(p.Measurement())
+}
+
+// ToPacked interprets every bit of the provided phrase as consecutive elements of W's width, ignoring measurement
+// boundaries entirely.
+//
+// NOTE: This will panic if the phrase's width is not a multiple of W's width.
+//
+// See NewPhraseOfPacked and ToPacked
+func ToPacked[W sub.Width](a Phrase) sub.Packed[W] {
+	return sub.NewPackedOf[W](num.NewMeasurement(a.GetAllBits()...))
+}
@@ -0,0 +1,122 @@
+package std
+
+import (
+	"core/sys/num"
+	"core/sys/support"
+)
+
+// Find returns the bit index of the first occurrence of the pattern within the phrase, or -1 if it's not present.
+//
+// NOTE: The search is performed at bit granularity and ignores measurement boundaries entirely - a pattern
+// may begin in one measurement and end several measurements later.
+//
+// See Phrase.Find, Phrase.FindAll, Phrase.Count, and Phrase.ReplaceAll
+func (a Phrase) Find(pattern num.Measurement) int {
+	found := a.find(pattern, 1)
+	if len(found) == 0 {
+		return -1
+	}
+	return found[0]
+}
+
+// FindAll returns the bit indices of every non-overlapping occurrence of the pattern within the phrase, in order.
+//
+// NOTE: Like strings.Count, the search resumes immediately after each match - so searching "0 1 0 1 0" for "0 1 0"
+// yields a single match at index 0.
+//
+// See Phrase.Find, Phrase.FindAll, Phrase.Count, and Phrase.ReplaceAll
+func (a Phrase) FindAll(pattern num.Measurement) []int {
+	return a.find(pattern, -1)
+}
+
+// Count returns the number of non-overlapping occurrences of the pattern within the phrase.
+//
+// See Phrase.Find, Phrase.FindAll, Phrase.Count, and Phrase.ReplaceAll
+func (a Phrase) Count(pattern num.Measurement) int {
+	return len(a.find(pattern, -1))
+}
+
+// ReplaceAll replaces every non-overlapping occurrence of the pattern with the replacement bits.
+//
+// Measurement boundaries outside of a match are retained, while boundaries that fall strictly within a match
+// are consumed - the replacement bits join the measurement in which the match began.  The resulting measurements
+// are recorded in big endian order.
+//
+// NOTE: The replacement may be of any width, including zero - which simply removes the pattern.
+//
+// See Phrase.Find, Phrase.FindAll, Phrase.Count, and Phrase.ReplaceAll
+func (a Phrase) ReplaceAll(pattern num.Measurement, replacement num.Measurement) Phrase {
+	matches := a.find(pattern, -1)
+	if len(matches) == 0 {
+		return a
+	}
+
+	width := int(pattern.BitWidth())
+	insert := replacement.GetAllBits()
+	bits := a.GetAllBits()
+
+	boundaries := make(map[int]bool, len(a.Data))
+	position := 0
+	for _, m := range a.Data {
+		position += int(m.BitWidth())
+		boundaries[position] = true
+	}
+
+	data := make([]num.Measurement, 0, len(a.Data))
+	current := make([]num.Bit, 0, width)
+	flush := func() {
+		if len(current) > 0 {
+			data = append(data, num.NewMeasurement(current...))
+			current = make([]num.Bit, 0, width)
+		}
+	}
+
+	for i, m := 0, 0; i < len(bits); {
+		if m < len(matches) && i == matches[m] {
+			current = append(current, insert...)
+			i += width
+			m++
+		} else {
+			current = append(current, bits[i])
+			i++
+		}
+
+		if boundaries[i] {
+			flush()
+		}
+	}
+	flush()
+
+	a.Data = data
+	return a
+}
+
+// find performs a Knuth-Morris-Pratt search of the phrase's bits, returning up to 'limit' non-overlapping
+// match indices - or all of them, if the limit is negative.
+func (a Phrase) find(pattern num.Measurement, limit int) []int {
+	needle := pattern.GetAllBits()
+	if len(needle) == 0 {
+		panic("cannot search for an empty pattern")
+	}
+
+	pi := support.PrefixFunction(needle)
+	out := make([]int, 0)
+
+	j := 0
+	for i, b := range a.GetAllBits() {
+		for j > 0 && b != needle[j] {
+			j = pi[j-1]
+		}
+		if b == needle[j] {
+			j++
+		}
+		if j == len(needle) {
+			out = append(out, i+1-len(needle))
+			if limit > 0 && len(out) >= limit {
+				return out
+			}
+			j = 0
+		}
+	}
+	return out
+}
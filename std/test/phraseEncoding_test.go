@@ -0,0 +1,110 @@
+package test
+
+import (
+	"core/enum/endian"
+	"core/std"
+	"core/sys/num"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+)
+
+func phrasesEqual(a, b std.Phrase) bool {
+	if a.Name.Name != b.Name.Name || len(a.Data) != len(b.Data) {
+		return false
+	}
+	for i := range a.Data {
+		if a.Data[i].String() != b.Data[i].String() || a.Data[i].Endianness != b.Data[i].Endianness {
+			return false
+		}
+	}
+	return true
+}
+
+func Test_Phrase_Encoding_RoundTrip(t *testing.T) {
+	cases := []std.Phrase{
+		std.NewPhraseNamed("Empty"),
+		std.NewPhraseNamed("Alex", num.NewMeasurement(0, 1), num.NewMeasurement(0, 1, 0), num.NewMeasurementOfBytes(0x68)),
+		std.NewPhraseNamed("Mixed", num.NewMeasurementOf[uint16](42, endian.Little), num.NewMeasurement(1)),
+	}
+
+	for _, p := range cases {
+		binary, err := p.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%s) failed: %v", p.StringPretty(), err)
+		}
+		var fromBinary std.Phrase
+		if err = fromBinary.UnmarshalBinary(binary); err != nil || !phrasesEqual(p, fromBinary) {
+			t.Errorf("binary round trip of %s = %s, %v", p.StringPretty(), fromBinary.StringPretty(), err)
+		}
+
+		j, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("MarshalJSON(%s) failed: %v", p.StringPretty(), err)
+		}
+		var fromJSON std.Phrase
+		if err = json.Unmarshal(j, &fromJSON); err != nil || !phrasesEqual(p, fromJSON) {
+			t.Errorf("JSON round trip of %s = %s, %v", j, fromJSON.StringPretty(), err)
+		}
+	}
+}
+
+func Test_Phrase_Text_RoundTrip(t *testing.T) {
+	p := std.NewPhraseNamed("Alex", num.NewMeasurement(0, 1), num.NewMeasurement(0, 1, 0), num.NewMeasurementOfBytes(0x68))
+
+	text, err := p.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+	if expected := "Alex | 0 1 - 0 1 0 - 0 1 1 0 1 0 0 0 |"; string(text) != expected {
+		t.Errorf("expected %q, got %q", expected, text)
+	}
+
+	var fromText std.Phrase
+	if err = fromText.UnmarshalText(text); err != nil || !phrasesEqual(p, fromText) {
+		t.Errorf("text round trip of %q = %s, %v", text, fromText.StringPretty(), err)
+	}
+}
+
+func Test_Phrase_Text_Endianness(t *testing.T) {
+	p := std.NewPhraseNamed("Mixed", num.NewMeasurementOf[uint16](42, endian.Little), num.NewMeasurement(1))
+
+	text, err := p.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+	if expected := "Mixed | 0 0 1 0 1 0 1 0 0 0 0 0 0 0 0 0 - 1 | LittleEndian BigEndian"; string(text) != expected {
+		t.Errorf("expected %q, got %q", expected, text)
+	}
+
+	var fromText std.Phrase
+	if err = fromText.UnmarshalText(text); err != nil || !phrasesEqual(p, fromText) {
+		t.Errorf("text round trip of %q = %s, %v", text, fromText.StringPretty(), err)
+	}
+
+	if err = fromText.UnmarshalText([]byte("Mixed | 0 1 - 1 | LittleEndian")); err == nil {
+		t.Error("expected a mismatched endianness count to fail")
+	}
+}
+
+func Test_Phrase_UnmarshalBinary_HugeCount(t *testing.T) {
+	// A one byte name, followed by a measurement count of 2⁶³ and no measurements
+	data := binary.AppendUvarint([]byte{1, 'A'}, 1<<63)
+
+	var decoded std.Phrase
+	if err := decoded.UnmarshalBinary(data); err == nil {
+		t.Error("expected an unbacked measurement count to fail")
+	}
+}
+
+func Test_Phrase_UnmarshalBinary_Truncated(t *testing.T) {
+	p := std.NewPhraseNamed("Alex", num.NewMeasurement(0, 1), num.NewMeasurementOfBytes(0x68))
+	binary, _ := p.MarshalBinary()
+
+	for i := 0; i < len(binary); i++ {
+		var decoded std.Phrase
+		if err := decoded.UnmarshalBinary(binary[:i]); err == nil {
+			t.Errorf("expected %d of %d bytes to fail", i, len(binary))
+		}
+	}
+}
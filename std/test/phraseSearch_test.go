@@ -0,0 +1,105 @@
+package test
+
+import (
+	"core/std"
+	"core/sys/num"
+	"slices"
+	"testing"
+)
+
+func Test_Phrase_FindAll_NonOverlapping(t *testing.T) {
+	phrase := std.NewPhrase(num.NewMeasurementOfBinaryString("01010"))
+	pattern := num.NewMeasurementOfBinaryString("010")
+
+	// The overlapping occurrence at index 2 shares a bit with the first match, so it's skipped
+	if found := phrase.FindAll(pattern); !slices.Equal(found, []int{0}) {
+		t.Errorf("expected [0], got %v", found)
+	}
+	if count := phrase.Count(pattern); count != 1 {
+		t.Errorf("expected a count of 1, got %d", count)
+	}
+
+	phrase = std.NewPhrase(num.NewMeasurementOfBinaryString("010010"))
+	if found := phrase.FindAll(pattern); !slices.Equal(found, []int{0, 3}) {
+		t.Errorf("expected [0 3], got %v", found)
+	}
+}
+
+func Test_Phrase_Find(t *testing.T) {
+	phrase := std.NewPhrase(num.NewMeasurementOfBinaryString("1100101"))
+
+	if i := phrase.Find(num.NewMeasurementOfBinaryString("101")); i != 4 {
+		t.Errorf("expected 4, got %d", i)
+	}
+	if i := phrase.Find(num.NewMeasurementOfBinaryString("111")); i != -1 {
+		t.Errorf("expected -1, got %d", i)
+	}
+}
+
+func Test_Phrase_Find_PatternLongerThanPhrase(t *testing.T) {
+	phrase := std.NewPhrase(num.NewMeasurementOfBinaryString("101"))
+	pattern := num.NewMeasurementOfBinaryString("10101")
+
+	if i := phrase.Find(pattern); i != -1 {
+		t.Errorf("expected -1, got %d", i)
+	}
+	if found := phrase.FindAll(pattern); len(found) != 0 {
+		t.Errorf("expected no matches, got %v", found)
+	}
+	if replaced := phrase.ReplaceAll(pattern, num.NewMeasurementOfBinaryString("0")); replaced.String() != "101" {
+		t.Errorf("expected the phrase to be unchanged, got %s", replaced.String())
+	}
+}
+
+func Test_Phrase_Find_EmptyPattern(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected an empty pattern to panic")
+		}
+	}()
+	std.NewPhrase(num.NewMeasurementOfBinaryString("101")).Find(num.NewMeasurement())
+}
+
+func Test_Phrase_Find_AcrossMeasurements(t *testing.T) {
+	phrase := std.NewPhrase(
+		num.NewMeasurementOfBinaryString("001"),
+		num.NewMeasurementOfBinaryString("1"),
+		num.NewMeasurementOfBinaryString("10"),
+	)
+
+	if i := phrase.Find(num.NewMeasurementOfBinaryString("1111")); i != -1 {
+		t.Errorf("expected -1, got %d", i)
+	}
+	if i := phrase.Find(num.NewMeasurementOfBinaryString("0111")); i != 1 {
+		t.Errorf("expected 1, got %d", i)
+	}
+}
+
+func Test_Phrase_ReplaceAll(t *testing.T) {
+	phrase := std.NewPhrase(
+		num.NewMeasurementOfBinaryString("110"),
+		num.NewMeasurementOfBinaryString("1011"),
+		num.NewMeasurementOfBinaryString("1"),
+	)
+
+	cases := []struct {
+		replacement string
+		expected    []string
+	}{
+		// The match crosses the first boundary, so the replacement joins the measurement it began in
+		{"0000", []string{"10000011", "1"}},
+		{"0", []string{"10011", "1"}},
+		{"", []string{"1011", "1"}},
+	}
+
+	for _, c := range cases {
+		replaced := phrase.ReplaceAll(num.NewMeasurementOfBinaryString("101"), num.NewMeasurementOfBinaryString(c.replacement))
+		out := make([]string, len(replaced.Data))
+		for i, m := range replaced.Data {
+			out[i] = m.String()
+		}
+		if !slices.Equal(out, c.expected) {
+			t.Errorf("replacing 101 with %q: expected %v, got %v", c.replacement, c.expected, out)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package test
+
+import (
+	"core/std"
+	"core/sys/num"
+	"strings"
+	"testing"
+)
+
+func Test_Phrase_Transpose(t *testing.T) {
+	phrase := std.NewPhrase(num.NewMeasurement(1, 1, 0), num.NewMeasurement(0, 1, 1))
+
+	if out := strings.TrimSpace(phrase.Transpose().StringPretty()); out != "| 1 0 - 1 1 - 0 1 |" {
+		t.Errorf("expected | 1 0 - 1 1 - 0 1 |, got %s", out)
+	}
+	if out := phrase.Transpose().Transpose().StringPretty(); out != phrase.StringPretty() {
+		t.Errorf("expected transposing twice to yield %s, got %s", phrase.StringPretty(), out)
+	}
+}
+
+func Test_Phrase_Transpose_Empty(t *testing.T) {
+	if out := std.NewPhrase().Transpose(); len(out.Data) != 0 {
+		t.Errorf("expected an empty phrase, got %s", out.StringPretty())
+	}
+}
+
+func Test_Phrase_Transpose_Ragged(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected measurements of different widths to panic")
+		}
+	}()
+	std.NewPhrase(num.NewMeasurement(1, 1, 0), num.NewMeasurement(0, 1)).Transpose()
+}
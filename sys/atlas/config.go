@@ -14,6 +14,7 @@ type config struct {
 	SeedRefractoryPeriod string  `json:"seedRefractoryPeriod"`
 	IncludeNilBits       *bool   `json:"includeNilBits"`
 	CompactVectors       *bool   `json:"compactVectors"`
+	Seed                 *uint64 `json:"seed"`
 }
 
 func (c config) apply() {
@@ -41,4 +42,7 @@ func (c config) apply() {
 	if c.CompactVectors != nil {
 		CompactVectors = *c.CompactVectors
 	}
+	if c.Seed != nil {
+		Seed = c.Seed
+	}
 }
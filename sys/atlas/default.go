@@ -45,6 +45,12 @@ var IncludeNilBits = false
 //	{x: xVal, y: yVal}
 var CompactVectors = false
 
+// Seed, when set, deterministically seeds the global entropy source - reproducing every periodically unique set,
+// random name, and shuffle exactly from run to run.
+//
+// NOTE: This defaults to nil, meaning the source is randomly seeded at startup.
+var Seed *uint64
+
 /**
 Constant References
 */
@@ -0,0 +1,87 @@
+// Package checksum provides bit-granular checksums and cyclic redundancy checks over std.Phrase data.
+//
+// Unlike hash/crc32 and its siblings, everything here operates on the phrase's individual bits - so frames
+// which don't land on a byte boundary can be checked exactly as they were captured.
+package checksum
+
+import (
+	"core/std"
+	"core/sys/num"
+)
+
+// Parity returns the parity bit of the provided phrase - which is the bit that, when appended, makes the number
+// of ones even.  If you'd prefer odd parity, pass 'true' to the odd parameter.
+func Parity(data std.Phrase, odd ...bool) num.Bit {
+	var parity num.Bit
+	for _, b := range data.GetAllBits() {
+		parity ^= b
+	}
+	if len(odd) > 0 && odd[0] {
+		parity ^= 1
+	}
+	return parity
+}
+
+// Fletcher16 calculates the Fletcher-16 checksum of the provided phrase, read as 8-bit words.
+//
+// NOTE: If the phrase isn't byte aligned, its final word is padded with trailing zeros.
+func Fletcher16(data std.Phrase) uint16 {
+	var a, b uint32
+	for _, w := range words(data, 8) {
+		a = (a + uint32(w)) % 255
+		b = (b + a) % 255
+	}
+	return uint16(b<<8 | a)
+}
+
+// Fletcher32 calculates the Fletcher-32 checksum of the provided phrase, read as 16-bit words.
+//
+// NOTE: Each word is formed from a pair of bytes with the first as its low byte, matching the common reference
+// implementation operating on a little endian []uint16.  If the phrase isn't 16-bit aligned, its final word is
+// padded with trailing zeros.
+func Fletcher32(data std.Phrase) uint32 {
+	bytes := words(data, 8)
+	if len(bytes)%2 != 0 {
+		bytes = append(bytes, 0)
+	}
+
+	var a, b uint64
+	for i := 0; i < len(bytes); i += 2 {
+		a = (a + (bytes[i] | bytes[i+1]<<8)) % 65535
+		b = (b + a) % 65535
+	}
+	return uint32(b<<16 | a)
+}
+
+// Adler32 calculates the Adler-32 checksum of the provided phrase, read as 8-bit words.
+//
+// NOTE: If the phrase isn't byte aligned, its final word is padded with trailing zeros.
+func Adler32(data std.Phrase) uint32 {
+	const modulus = 65521
+
+	a, b := uint32(1), uint32(0)
+	for _, w := range words(data, 8) {
+		a = (a + uint32(w)) % modulus
+		b = (b + a) % modulus
+	}
+	return b<<16 | a
+}
+
+// words reads the phrase's bits as a sequence of big endian words of the provided width, padding the final
+// word with trailing zeros.
+func words(data std.Phrase, width int) []uint64 {
+	bits := data.GetAllBits()
+	out := make([]uint64, 0, (len(bits)+width-1)/width)
+
+	for i := 0; i < len(bits); i += width {
+		var w uint64
+		for ii := i; ii < i+width; ii++ {
+			w <<= 1
+			if ii < len(bits) {
+				w |= uint64(bits[ii])
+			}
+		}
+		out = append(out, w)
+	}
+	return out
+}
@@ -0,0 +1,152 @@
+package checksum
+
+import (
+	"core/std"
+	"core/sys/num"
+	"fmt"
+)
+
+// CRC describes a cyclic redundancy check using the Rocksoft™ parameter model.  Because the engine walks
+// the phrase one bit at a time, it can checksum frames of any bit width - not just whole bytes.
+//
+//	Width - The number of bits in the register, from 1 to 64
+//	Polynomial - The generator polynomial, without its implied leading term
+//	Init - The register's initial value
+//	ReflectIn - Whether each 8-bit group of input is processed least significant bit first
+//	ReflectOut - Whether the final register is reflected before XorOut is applied
+//	XorOut - The value XORed against the final register
+//	Check - The expected checksum of the ASCII string "123456789" - see CRC.SelfTest
+//
+// NOTE: When reflecting the input, a trailing group of fewer than 8 bits is reflected within its own width.
+//
+// See CRC5USB, CRC8, CRC16CCITT, CRC16Kermit, CRC16XModem, CRC32, CRC32C, and CRC64ECMA
+type CRC struct {
+	Name       string
+	Width      uint
+	Polynomial uint64
+	Init       uint64
+	ReflectIn  bool
+	ReflectOut bool
+	XorOut     uint64
+	Check      uint64
+}
+
+var (
+	// CRC5USB is the 5-bit CRC used by USB token packets.
+	CRC5USB = CRC{Name: "CRC-5/USB", Width: 5, Polynomial: 0x05, Init: 0x1F, ReflectIn: true, ReflectOut: true, XorOut: 0x1F, Check: 0x19}
+
+	// CRC8 is the 8-bit CRC used by SMBus.
+	CRC8 = CRC{Name: "CRC-8/SMBUS", Width: 8, Polynomial: 0x07, Check: 0xF4}
+
+	// CRC16CCITT is the 16-bit CCITT polynomial as it's most commonly implemented, with an initial value of 0xFFFF.
+	CRC16CCITT = CRC{Name: "CRC-16/CCITT-FALSE", Width: 16, Polynomial: 0x1021, Init: 0xFFFF, Check: 0x29B1}
+
+	// CRC16Kermit is the reflected 16-bit CCITT polynomial, as used by Kermit and Bluetooth.
+	CRC16Kermit = CRC{Name: "CRC-16/KERMIT", Width: 16, Polynomial: 0x1021, ReflectIn: true, ReflectOut: true, Check: 0x2189}
+
+	// CRC16XModem is the unreflected 16-bit CCITT polynomial with an initial value of zero.
+	CRC16XModem = CRC{Name: "CRC-16/XMODEM", Width: 16, Polynomial: 0x1021, Check: 0x31C3}
+
+	// CRC32 is the 32-bit CRC used by Ethernet, zip, and PNG - identical to hash/crc32's IEEE table.
+	CRC32 = CRC{Name: "CRC-32", Width: 32, Polynomial: 0x04C11DB7, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, XorOut: 0xFFFFFFFF, Check: 0xCBF43926}
+
+	// CRC32C is the 32-bit Castagnoli CRC used by iSCSI and SCTP.
+	CRC32C = CRC{Name: "CRC-32C", Width: 32, Polynomial: 0x1EDC6F41, Init: 0xFFFFFFFF, ReflectIn: true, ReflectOut: true, XorOut: 0xFFFFFFFF, Check: 0xE3069283}
+
+	// CRC64ECMA is the 64-bit CRC defined by ECMA-182.
+	CRC64ECMA = CRC{Name: "CRC-64/ECMA-182", Width: 64, Polynomial: 0x42F0E1EBA9EA3693, Check: 0x6C40DF5F0B497347}
+)
+
+// Sum calculates the CRC of the provided phrase's bits.
+func (c CRC) Sum(data std.Phrase) uint64 {
+	c.sanityCheck()
+
+	mask := ^uint64(0) >> (64 - c.Width)
+	top := uint(c.Width - 1)
+	register := c.Init & mask
+
+	bits := data.GetAllBits()
+	for i := 0; i < len(bits); i++ {
+		b := bits[i]
+		if c.ReflectIn {
+			group := i - i%8
+			width := min(8, len(bits)-group)
+			b = bits[group+width-1-(i-group)]
+		}
+
+		feedback := (register>>top)&1 ^ uint64(b)
+		register = (register << 1) & mask
+		if feedback == 1 {
+			register ^= c.Polynomial & mask
+		}
+	}
+
+	if c.ReflectOut {
+		register = reflect(register, c.Width)
+	}
+	return (register ^ c.XorOut) & mask
+}
+
+// Measure calculates the CRC of the provided phrase's bits and returns it as a measurement of CRC.Width bits.
+func (c CRC) Measure(data std.Phrase) num.Measurement {
+	sum := c.Sum(data)
+
+	bits := make([]num.Bit, c.Width)
+	for i := uint(0); i < c.Width; i++ {
+		bits[c.Width-1-i] = num.Bit((sum >> i) & 1)
+	}
+	return num.NewMeasurement(bits...)
+}
+
+// Append places the CRC of the provided phrase at its end, producing a checked frame.
+//
+// See CRC.Append and CRC.Verify
+func (c CRC) Append(data std.Phrase) std.Phrase {
+	return data.AppendMeasurement(c.Measure(data))
+}
+
+// Verify checks that the final CRC.Width bits of the provided frame hold the CRC of the bits preceding them.
+//
+// See CRC.Append and CRC.Verify
+func (c CRC) Verify(frame std.Phrase) bool {
+	c.sanityCheck()
+
+	bits := frame.GetAllBits()
+	if uint(len(bits)) < c.Width {
+		return false
+	}
+
+	split := uint(len(bits)) - c.Width
+	body := std.NewPhrase(num.NewMeasurement(bits[:split]...))
+
+	var expected uint64
+	for _, b := range bits[split:] {
+		expected = expected<<1 | uint64(b)
+	}
+	return c.Sum(body) == expected
+}
+
+// SelfTest calculates the CRC of the ASCII string "123456789" and compares it against CRC.Check.
+func (c CRC) SelfTest() bool {
+	return c.Sum(std.NewPhrase(num.NewMeasurementOfBytes([]byte("123456789")...))) == c.Check
+}
+
+// String returns the CRC's name.
+func (c CRC) String() string {
+	return c.Name
+}
+
+func (c CRC) sanityCheck() {
+	if c.Width == 0 || c.Width > 64 {
+		panic(fmt.Sprintf("invalid CRC width: %d - must be in [1, 64]", c.Width))
+	}
+}
+
+// reflect reverses the order of the lowest 'width' bits of the provided value.
+func reflect(value uint64, width uint) uint64 {
+	var out uint64
+	for i := uint(0); i < width; i++ {
+		out = out<<1 | (value>>i)&1
+	}
+	return out
+}
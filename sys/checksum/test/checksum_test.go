@@ -0,0 +1,70 @@
+package test
+
+import (
+	"core/std"
+	"core/sys/checksum"
+	"core/sys/num"
+	"hash/crc32"
+	"testing"
+)
+
+func Test_CRC_Check(t *testing.T) {
+	presets := []checksum.CRC{
+		checksum.CRC5USB, checksum.CRC8, checksum.CRC16CCITT, checksum.CRC16Kermit,
+		checksum.CRC16XModem, checksum.CRC32, checksum.CRC32C, checksum.CRC64ECMA,
+	}
+
+	for _, c := range presets {
+		if !c.SelfTest() {
+			t.Errorf("%v failed its check value", c)
+		}
+	}
+}
+
+func Test_CRC_MatchesHashCRC32(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+	phrase := std.NewPhrase(num.NewMeasurementOfBytes(data...))
+
+	if got, want := checksum.CRC32.Sum(phrase), uint64(crc32.ChecksumIEEE(data)); got != want {
+		t.Errorf("CRC32.Sum = %#x, want %#x", got, want)
+	}
+}
+
+func Test_CRC_Verify(t *testing.T) {
+	phrase := std.NewPhrase(num.NewMeasurement(1, 0, 1, 1, 0, 0, 1, 0, 1, 1, 1))
+	frame := checksum.CRC5USB.Append(phrase)
+
+	if frame.BitWidth() != 16 {
+		t.Fatalf("expected a 16 bit frame, got %d", frame.BitWidth())
+	}
+	if !checksum.CRC5USB.Verify(frame) {
+		t.Errorf("a valid frame failed verification")
+	}
+
+	bits := frame.GetAllBits()
+	bits[3] ^= 1
+	corrupted := std.NewPhrase(num.NewMeasurement(bits...))
+	if checksum.CRC5USB.Verify(corrupted) {
+		t.Errorf("a corrupted frame passed verification")
+	}
+}
+
+func Test_Checksums(t *testing.T) {
+	abcde := std.NewPhrase(num.NewMeasurementOfBytes([]byte("abcde")...))
+
+	if got := checksum.Fletcher16(abcde); got != 0xC8F0 {
+		t.Errorf("Fletcher16 = %#x, want 0xc8f0", got)
+	}
+	if got := checksum.Fletcher32(abcde); got != 0xF04FC729 {
+		t.Errorf("Fletcher32 = %#x, want 0xf04fc729", got)
+	}
+
+	wikipedia := std.NewPhrase(num.NewMeasurementOfBytes([]byte("Wikipedia")...))
+	if got := checksum.Adler32(wikipedia); got != 0x11E60398 {
+		t.Errorf("Adler32 = %#x, want 0x11e60398", got)
+	}
+
+	if checksum.Parity(abcde) != 1 || checksum.Parity(abcde, true) != 0 {
+		t.Errorf("incorrect parity")
+	}
+}
@@ -0,0 +1,143 @@
+// Package codec provides lossless compression of std.Phrase bitstreams at bit granularity.
+//
+// Byte oriented compressors discard the sub-byte structure of the data they're handed - these codecs instead
+// operate directly on the phrase's bits, so sparse bitmaps and odd-width symbols compress as they were measured.
+//
+// See RLE, EliasGamma, EliasDelta, Huffman, and Analyze
+package codec
+
+import (
+	"core/std"
+	"core/sys/num"
+	"errors"
+	"fmt"
+)
+
+// Codec represents a lossless transformation of a phrase into a compressed phrase, and back again.
+type Codec interface {
+	// Encode compresses the provided phrase.
+	Encode(data std.Phrase) std.Phrase
+
+	// Decode restores a phrase compressed by Encode.
+	Decode(encoded std.Phrase) (std.Phrase, error)
+}
+
+// ErrTruncated is returned when encoded data ends before it could be completely decoded.
+var ErrTruncated = errors.New("codec: encoded data is truncated")
+
+// MaxDecodedWidth is the largest number of bits a decoder will expand a phrase into.  Run lengths are not bounded by
+// the size of their encoding, so a corrupt run could otherwise claim far more memory than any real phrase requires.
+var MaxDecodedWidth uint64 = 1 << 28
+
+// sanityCheckWidth panics if the provided symbol width is outside the closed interval [1, 32].
+func sanityCheckWidth(width uint) {
+	if width == 0 || width > 32 {
+		panic(fmt.Sprintf("invalid symbol width: %d - must be in [1, 32]", width))
+	}
+}
+
+// phraseOf creates an anonymous phrase holding the provided bits as a single measurement.
+func phraseOf(bits []num.Bit) std.Phrase {
+	return std.NewPhrase(num.NewMeasurement(bits...))
+}
+
+/**
+Bit Streams
+*/
+
+type bitWriter struct {
+	bits []num.Bit
+}
+
+// write appends the lowest 'width' bits of the provided value, most significant bit first.
+func (w *bitWriter) write(value uint64, width uint) {
+	for i := width; i > 0; i-- {
+		w.bits = append(w.bits, num.Bit((value>>(i-1))&1))
+	}
+}
+
+// gamma appends the Elias gamma code of n, which must be at least 1.
+func (w *bitWriter) gamma(n uint64) {
+	if n == 0 {
+		panic("cannot Elias code zero")
+	}
+	length := bitLength(n)
+	w.write(0, length-1)
+	w.write(n, length)
+}
+
+// delta appends the Elias delta code of n, which must be at least 1.
+func (w *bitWriter) delta(n uint64) {
+	if n == 0 {
+		panic("cannot Elias code zero")
+	}
+	length := bitLength(n)
+	w.gamma(uint64(length))
+	w.write(n, length-1)
+}
+
+type bitReader struct {
+	bits     []num.Bit
+	position int
+}
+
+func (r *bitReader) remaining() int {
+	return len(r.bits) - r.position
+}
+
+// read consumes 'width' bits, most significant bit first.
+func (r *bitReader) read(width uint) (uint64, error) {
+	if r.remaining() < int(width) {
+		return 0, ErrTruncated
+	}
+	var value uint64
+	for i := uint(0); i < width; i++ {
+		value = value<<1 | uint64(r.bits[r.position])
+		r.position++
+	}
+	return value, nil
+}
+
+// gamma consumes an Elias gamma code.
+func (r *bitReader) gamma() (uint64, error) {
+	zeros := uint(0)
+	for {
+		if r.remaining() == 0 {
+			return 0, ErrTruncated
+		}
+		if r.bits[r.position] == 1 {
+			break
+		}
+		zeros++
+		r.position++
+	}
+	if zeros > 63 {
+		return 0, errors.New("codec: Elias code exceeds 64 bits")
+	}
+	return r.read(zeros + 1)
+}
+
+// delta consumes an Elias delta code.
+func (r *bitReader) delta() (uint64, error) {
+	length, err := r.gamma()
+	if err != nil {
+		return 0, err
+	}
+	if length > 64 {
+		return 0, errors.New("codec: Elias code exceeds 64 bits")
+	}
+	value, err := r.read(uint(length) - 1)
+	if err != nil {
+		return 0, err
+	}
+	return 1<<(length-1) | value, nil
+}
+
+// bitLength returns the number of bits required to represent n.
+func bitLength(n uint64) uint {
+	length := uint(0)
+	for ; n > 0; n >>= 1 {
+		length++
+	}
+	return length
+}
@@ -0,0 +1,123 @@
+package codec
+
+import (
+	"core/std"
+	"core/sys/num"
+	"errors"
+	"fmt"
+)
+
+// EliasGamma is a universal codec which reads the data as symbols of Width bits and records each symbol's value
+// (plus one) as an Elias gamma code - favouring data dominated by small values.
+//
+//	n → 1     2       3       4         5
+//	γ → 1   0 1 0   0 1 1   0 0 1 0 0   0 0 1 0 1
+//
+// The encoded phrase begins with the Elias gamma code of the source bit width (plus one), allowing a trailing
+// symbol narrower than Width to be restored exactly.
+//
+// NOTE: If no Width is set, 8 bits per symbol will be used.
+//
+// See Codec, RLE, EliasGamma, EliasDelta, and Huffman
+type EliasGamma struct {
+	Width uint
+}
+
+// Encode compresses the provided phrase into Elias gamma codes.
+func (c EliasGamma) Encode(data std.Phrase) std.Phrase {
+	return eliasEncode(data, symbolWidth(c.Width), (*bitWriter).gamma)
+}
+
+// Decode expands a phrase compressed by Encode.
+func (c EliasGamma) Decode(encoded std.Phrase) (std.Phrase, error) {
+	return eliasDecode(encoded, symbolWidth(c.Width), (*bitReader).gamma)
+}
+
+// EliasDelta is a universal codec which reads the data as symbols of Width bits and records each symbol's value
+// (plus one) as an Elias delta code - which grows more slowly than EliasGamma for larger values.
+//
+//	n → 1      2         3         4           5
+//	δ → 1   0 1 0 0   0 1 0 1   0 1 1 0 0   0 1 1 0 1
+//
+// The encoded phrase begins with the Elias delta code of the source bit width (plus one), allowing a trailing
+// symbol narrower than Width to be restored exactly.
+//
+// NOTE: If no Width is set, 8 bits per symbol will be used.
+//
+// See Codec, RLE, EliasGamma, EliasDelta, and Huffman
+type EliasDelta struct {
+	Width uint
+}
+
+// Encode compresses the provided phrase into Elias delta codes.
+func (c EliasDelta) Encode(data std.Phrase) std.Phrase {
+	return eliasEncode(data, symbolWidth(c.Width), (*bitWriter).delta)
+}
+
+// Decode expands a phrase compressed by Encode.
+func (c EliasDelta) Decode(encoded std.Phrase) (std.Phrase, error) {
+	return eliasDecode(encoded, symbolWidth(c.Width), (*bitReader).delta)
+}
+
+func eliasEncode(data std.Phrase, width uint, code func(*bitWriter, uint64)) std.Phrase {
+	bits := data.GetAllBits()
+
+	w := &bitWriter{}
+	code(w, uint64(len(bits))+1)
+	for i := 0; i < len(bits); i += int(width) {
+		symbol := bits[i:min(i+int(width), len(bits))]
+		code(w, valueOf(symbol)+1)
+	}
+	return phraseOf(w.bits)
+}
+
+func eliasDecode(encoded std.Phrase, width uint, code func(*bitReader) (uint64, error)) (std.Phrase, error) {
+	r := &bitReader{bits: encoded.GetAllBits()}
+
+	total, err := code(r)
+	if err != nil {
+		return std.Phrase{}, err
+	}
+	if total == 0 {
+		return std.Phrase{}, errors.New("codec: invalid Elias length header")
+	}
+	total--
+
+	// Every symbol occupies at least one encoded bit, so the header can't claim more than that
+	if total > uint64(r.remaining())*uint64(width) {
+		return std.Phrase{}, fmt.Errorf("codec: Elias length header of %d bits exceeds the encoded data", total)
+	}
+
+	out := &bitWriter{bits: make([]num.Bit, 0, total)}
+	for remaining := total; remaining > 0; {
+		value, err := code(r)
+		if err != nil {
+			return std.Phrase{}, err
+		}
+		w := min(uint64(width), remaining)
+		if value-1 >= 1<<w {
+			return std.Phrase{}, fmt.Errorf("codec: Elias symbol %d exceeds %d bits", value-1, w)
+		}
+		out.write(value-1, uint(w))
+		remaining -= w
+	}
+	return phraseOf(out.bits), nil
+}
+
+// symbolWidth sanity checks the provided symbol width, defaulting to 8 bits when zero.
+func symbolWidth(width uint) uint {
+	if width == 0 {
+		return 8
+	}
+	sanityCheckWidth(width)
+	return width
+}
+
+// valueOf interprets the provided bits as a big endian unsigned value.
+func valueOf(bits []num.Bit) uint64 {
+	var value uint64
+	for _, b := range bits {
+		value = value<<1 | uint64(b)
+	}
+	return value
+}
@@ -0,0 +1,228 @@
+package codec
+
+import (
+	"container/heap"
+	"core/std"
+	"errors"
+	"slices"
+)
+
+// Huffman is a canonical Huffman codec, which reads the data as symbols of Width bits and assigns the most
+// frequent symbols the shortest prefix codes.
+//
+// The code table is embedded in the encoded phrase, making it entirely self-describing -
+//
+//	| γ(width) | γ(bit width + 1) | γ(symbol count) | [ symbol | γ(code length) ]… | codes… | trailing bits |
+//
+// Because the codes are canonical, only each symbol's code length needs recording - the codes themselves are
+// reassigned in order of (length, symbol) when decoding.
+//
+// NOTE: If the data isn't aligned to Width, its trailing bits are recorded verbatim after the codes.
+//
+// NOTE: If no Width is set, 8 bits per symbol will be used.
+//
+// See Codec, RLE, EliasGamma, EliasDelta, and Huffman
+type Huffman struct {
+	Width uint
+}
+
+// Encode compresses the provided phrase using a code table built from its symbol frequencies.
+func (c Huffman) Encode(data std.Phrase) std.Phrase {
+	width := symbolWidth(c.Width)
+	bits := data.GetAllBits()
+	whole := len(bits) - len(bits)%int(width)
+
+	symbols := make([]uint64, 0, whole/int(width))
+	frequencies := make(map[uint64]uint)
+	for i := 0; i < whole; i += int(width) {
+		symbol := valueOf(bits[i : i+int(width)])
+		symbols = append(symbols, symbol)
+		frequencies[symbol]++
+	}
+
+	table := canonicalCodes(huffmanLengths(frequencies))
+
+	w := &bitWriter{}
+	w.gamma(uint64(width))
+	w.gamma(uint64(len(bits)) + 1)
+	w.gamma(uint64(len(table)) + 1)
+	for _, entry := range table {
+		w.write(entry.symbol, width)
+		w.gamma(uint64(entry.length))
+	}
+
+	codes := make(map[uint64]huffmanCode, len(table))
+	for _, entry := range table {
+		codes[entry.symbol] = entry
+	}
+	for _, symbol := range symbols {
+		w.write(codes[symbol].code, codes[symbol].length)
+	}
+
+	w.bits = append(w.bits, bits[whole:]...)
+	return phraseOf(w.bits)
+}
+
+// Decode expands a phrase compressed by Encode.
+func (c Huffman) Decode(encoded std.Phrase) (std.Phrase, error) {
+	r := &bitReader{bits: encoded.GetAllBits()}
+
+	header := make([]uint64, 3)
+	for i := range header {
+		value, err := r.gamma()
+		if err != nil {
+			return std.Phrase{}, err
+		}
+		header[i] = value
+	}
+	width, total, count := uint(header[0]), header[1]-1, header[2]-1
+	if width > 32 {
+		return std.Phrase{}, errors.New("codec: invalid Huffman symbol width")
+	}
+
+	lengths := make(map[uint64]uint, count)
+	for i := uint64(0); i < count; i++ {
+		symbol, err := r.read(width)
+		if err != nil {
+			return std.Phrase{}, err
+		}
+		length, err := r.gamma()
+		if err != nil {
+			return std.Phrase{}, err
+		}
+		if length > 64 {
+			return std.Phrase{}, errors.New("codec: invalid Huffman code length")
+		}
+		lengths[symbol] = uint(length)
+	}
+
+	type key struct {
+		code   uint64
+		length uint
+	}
+	symbols := make(map[key]uint64, count)
+	for _, entry := range canonicalCodes(lengths) {
+		symbols[key{entry.code, entry.length}] = entry.symbol
+	}
+
+	out := &bitWriter{}
+	whole := total - total%uint64(width)
+	for uint64(len(out.bits)) < whole {
+		var k key
+		for {
+			bit, err := r.read(1)
+			if err != nil {
+				return std.Phrase{}, err
+			}
+			k.code = k.code<<1 | bit
+			k.length++
+			if symbol, ok := symbols[k]; ok {
+				out.write(symbol, width)
+				break
+			}
+			if k.length > 64 {
+				return std.Phrase{}, errors.New("codec: invalid Huffman code")
+			}
+		}
+	}
+
+	trailing, err := r.read(uint(total - whole))
+	if err != nil {
+		return std.Phrase{}, err
+	}
+	out.write(trailing, uint(total-whole))
+	return phraseOf(out.bits), nil
+}
+
+type huffmanCode struct {
+	symbol uint64
+	code   uint64
+	length uint
+}
+
+// canonicalCodes assigns canonical prefix codes to the provided code lengths, in order of (length, symbol).
+func canonicalCodes(lengths map[uint64]uint) []huffmanCode {
+	table := make([]huffmanCode, 0, len(lengths))
+	for symbol, length := range lengths {
+		table = append(table, huffmanCode{symbol: symbol, length: length})
+	}
+	slices.SortFunc(table, func(a, b huffmanCode) int {
+		if a.length != b.length {
+			return int(a.length) - int(b.length)
+		}
+		if a.symbol < b.symbol {
+			return -1
+		}
+		return 1
+	})
+
+	code := uint64(0)
+	for i := range table {
+		if i > 0 {
+			code = (code + 1) << (table[i].length - table[i-1].length)
+		}
+		table[i].code = code
+	}
+	return table
+}
+
+// huffmanLengths builds a Huffman tree from the provided frequencies and returns each symbol's depth within it.
+//
+// NOTE: A lone symbol is given a length of 1, as it still requires a code to be written.
+func huffmanLengths(frequencies map[uint64]uint) map[uint64]uint {
+	lengths := make(map[uint64]uint, len(frequencies))
+	if len(frequencies) == 1 {
+		for symbol := range frequencies {
+			lengths[symbol] = 1
+		}
+		return lengths
+	}
+
+	nodes := make(huffmanHeap, 0, len(frequencies))
+	for symbol, frequency := range frequencies {
+		nodes = append(nodes, &huffmanNode{weight: frequency, order: symbol, symbols: []uint64{symbol}})
+	}
+	heap.Init(&nodes)
+
+	for nodes.Len() > 1 {
+		a := heap.Pop(&nodes).(*huffmanNode)
+		b := heap.Pop(&nodes).(*huffmanNode)
+		for _, symbol := range a.symbols {
+			lengths[symbol]++
+		}
+		for _, symbol := range b.symbols {
+			lengths[symbol]++
+		}
+		heap.Push(&nodes, &huffmanNode{
+			weight:  a.weight + b.weight,
+			order:   min(a.order, b.order),
+			symbols: append(a.symbols, b.symbols...),
+		})
+	}
+	return lengths
+}
+
+type huffmanNode struct {
+	weight  uint
+	order   uint64
+	symbols []uint64
+}
+
+// huffmanHeap is a min-heap of nodes by weight, broken by their lowest symbol to keep the tree deterministic.
+type huffmanHeap []*huffmanNode
+
+func (h huffmanHeap) Len() int { return len(h) }
+func (h huffmanHeap) Less(i, j int) bool {
+	if h[i].weight != h[j].weight {
+		return h[i].weight < h[j].weight
+	}
+	return h[i].order < h[j].order
+}
+func (h huffmanHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *huffmanHeap) Push(x any)   { *h = append(*h, x.(*huffmanNode)) }
+func (h *huffmanHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
@@ -0,0 +1,67 @@
+package codec
+
+import (
+	"core/std"
+	"core/sys/num"
+	"fmt"
+)
+
+// RLE is a bit-level run-length codec, which excels at sparse data where long runs of a single bit dominate.
+//
+// The first bit of the data is recorded verbatim, followed by the Elias gamma code of every alternating run -
+//
+//	| 0 0 0 0 0 0 1 0 0 0 0 |  ← Data
+//	| 0 | 0 0 1 1 0 | 1 | 0 0 1 0 0 |  ← RLE (0, γ(6), γ(1), γ(4))
+//
+// See Codec, RLE, EliasGamma, EliasDelta, and Huffman
+type RLE struct{}
+
+// Encode compresses the provided phrase into alternating run lengths.
+func (RLE) Encode(data std.Phrase) std.Phrase {
+	bits := data.GetAllBits()
+	if len(bits) == 0 {
+		return phraseOf(nil)
+	}
+
+	w := &bitWriter{}
+	w.write(uint64(bits[0]), 1)
+
+	run := uint64(1)
+	for i := 1; i < len(bits); i++ {
+		if bits[i] == bits[i-1] {
+			run++
+			continue
+		}
+		w.gamma(run)
+		run = 1
+	}
+	w.gamma(run)
+	return phraseOf(w.bits)
+}
+
+// Decode expands a phrase compressed by Encode.
+func (RLE) Decode(encoded std.Phrase) (std.Phrase, error) {
+	r := &bitReader{bits: encoded.GetAllBits()}
+	if r.remaining() == 0 {
+		return phraseOf(nil), nil
+	}
+
+	first, _ := r.read(1)
+	current := num.Bit(first)
+
+	out := make([]num.Bit, 0, len(r.bits))
+	for r.remaining() > 0 {
+		run, err := r.gamma()
+		if err != nil {
+			return std.Phrase{}, err
+		}
+		if run > MaxDecodedWidth-uint64(len(out)) {
+			return std.Phrase{}, fmt.Errorf("codec: run of %d bits exceeds the %d bit decoding limit", run, MaxDecodedWidth)
+		}
+		for i := uint64(0); i < run; i++ {
+			out = append(out, current)
+		}
+		current ^= 1
+	}
+	return phraseOf(out), nil
+}
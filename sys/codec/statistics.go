@@ -0,0 +1,125 @@
+package codec
+
+import (
+	"core/std"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Statistics describes the bit and symbol frequencies of a phrase, allowing you to judge how compressible it is
+// before choosing a Codec.
+//
+//	Bits - The total bit width of the phrase
+//	Zeros, Ones - The number of each bit value
+//	BitEntropy - The Shannon entropy of a single bit, in the closed interval [0.0, 1.0]
+//	Runs - The number of runs of identical bits
+//	LongestRun - The longest run of identical bits
+//	SymbolWidth - The bit width used when reading the phrase as symbols
+//	Symbols - The frequency of every whole symbol observed
+//	SymbolEntropy - The Shannon entropy of a single symbol, in bits
+//
+// NOTE: Trailing bits which don't form a whole symbol are excluded from the symbol frequencies.
+//
+// See Analyze
+type Statistics struct {
+	Bits          uint
+	Zeros         uint
+	Ones          uint
+	BitEntropy    float64
+	Runs          uint
+	LongestRun    uint
+	SymbolWidth   uint
+	Symbols       map[uint64]uint
+	SymbolEntropy float64
+}
+
+// Analyze gathers the bit and symbol statistics of the provided phrase.
+//
+// NOTE: If no symbol width is provided, 8 bits per symbol will be used.
+func Analyze(data std.Phrase, symbolWidth ...uint) Statistics {
+	width := uint(8)
+	if len(symbolWidth) > 0 {
+		width = symbolWidth[0]
+	}
+	sanityCheckWidth(width)
+
+	bits := data.GetAllBits()
+	s := Statistics{
+		Bits:        uint(len(bits)),
+		SymbolWidth: width,
+		Symbols:     make(map[uint64]uint),
+	}
+
+	run := uint(0)
+	for i, b := range bits {
+		if b == 1 {
+			s.Ones++
+		} else {
+			s.Zeros++
+		}
+
+		if i > 0 && b == bits[i-1] {
+			run++
+		} else {
+			s.Runs++
+			run = 1
+		}
+		s.LongestRun = max(s.LongestRun, run)
+	}
+	s.BitEntropy = entropy(map[uint64]uint{0: s.Zeros, 1: s.Ones})
+
+	for i := 0; i+int(width) <= len(bits); i += int(width) {
+		s.Symbols[valueOf(bits[i:i+int(width)])]++
+	}
+	s.SymbolEntropy = entropy(s.Symbols)
+	return s
+}
+
+// MeanRun returns the average length of a run of identical bits.
+func (s Statistics) MeanRun() float64 {
+	if s.Runs == 0 {
+		return 0
+	}
+	return float64(s.Bits) / float64(s.Runs)
+}
+
+// MinimumBits returns the theoretical minimum number of bits required to encode the phrase's whole symbols
+// with an order-0 entropy coder - the bound a Huffman codec approaches.
+func (s Statistics) MinimumBits() float64 {
+	count := uint(0)
+	for _, frequency := range s.Symbols {
+		count += frequency
+	}
+	return s.SymbolEntropy * float64(count)
+}
+
+// String returns a human-readable summary of the statistics.
+func (s Statistics) String() string {
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("%d bits (%d zeros, %d ones) - %.4f bits of entropy per bit\n", s.Bits, s.Zeros, s.Ones, s.BitEntropy))
+	builder.WriteString(fmt.Sprintf("%d runs - longest %d, mean %.2f\n", s.Runs, s.LongestRun, s.MeanRun()))
+	builder.WriteString(fmt.Sprintf("%d unique %d-bit symbols - %.4f bits of entropy per symbol (≥ %.0f bits)", len(s.Symbols), s.SymbolWidth, s.SymbolEntropy, math.Ceil(s.MinimumBits())))
+	return builder.String()
+}
+
+// entropy calculates the Shannon entropy, in bits, of the provided frequencies.
+func entropy(frequencies map[uint64]uint) float64 {
+	total := uint(0)
+	for _, frequency := range frequencies {
+		total += frequency
+	}
+	if total == 0 {
+		return 0
+	}
+
+	h := 0.0
+	for _, frequency := range frequencies {
+		if frequency == 0 {
+			continue
+		}
+		p := float64(frequency) / float64(total)
+		h -= p * math.Log2(p)
+	}
+	return h
+}
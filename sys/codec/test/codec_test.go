@@ -0,0 +1,113 @@
+package test
+
+import (
+	"core/std"
+	"core/sys/codec"
+	"core/sys/num"
+	"testing"
+)
+
+// sparse is a 203 bit bitmap with a handful of set bits.
+var sparse = func() std.Phrase {
+	bits := make([]num.Bit, 203)
+	for _, i := range []int{3, 4, 90, 150, 151, 152, 202} {
+		bits[i] = 1
+	}
+	return std.NewPhrase(num.NewMeasurement(bits...))
+}()
+
+var text = std.NewPhrase(num.NewMeasurementOfBytes([]byte("abracadabra, abracadabra!")...)).Append(1, 0, 1)
+
+func Test_Codecs_RoundTrip(t *testing.T) {
+	codecs := map[string]codec.Codec{
+		"RLE":         codec.RLE{},
+		"EliasGamma":  codec.EliasGamma{},
+		"EliasDelta":  codec.EliasDelta{Width: 5},
+		"Huffman":     codec.Huffman{},
+		"Huffman(3)":  codec.Huffman{Width: 3},
+		"Huffman(13)": codec.Huffman{Width: 13},
+	}
+
+	for name, c := range codecs {
+		for _, data := range []std.Phrase{sparse, text, std.NewPhrase()} {
+			decoded, err := c.Decode(c.Encode(data))
+			if err != nil {
+				t.Fatalf("%v: %v", name, err)
+			}
+			if decoded.String() != data.String() {
+				t.Errorf("%v: decoded %v, want %v", name, decoded.String(), data.String())
+			}
+		}
+	}
+}
+
+func Test_RLE_CompressesSparseData(t *testing.T) {
+	encoded := codec.RLE{}.Encode(sparse)
+	if encoded.BitWidth() >= sparse.BitWidth()/4 {
+		t.Errorf("expected RLE to compress %d sparse bits below a quarter, got %d", sparse.BitWidth(), encoded.BitWidth())
+	}
+}
+
+func Test_Huffman_ApproachesEntropy(t *testing.T) {
+	stats := codec.Analyze(text)
+	encoded := codec.Huffman{}.Encode(text)
+
+	// The payload alone is within one bit per symbol of the entropy bound - the header is extra
+	count := text.BitWidth() / 8
+	if float64(encoded.BitWidth()) > stats.MinimumBits()+float64(count)+200 {
+		t.Errorf("Huffman produced %d bits, with a bound of %.0f", encoded.BitWidth(), stats.MinimumBits())
+	}
+}
+
+func Test_Analyze(t *testing.T) {
+	stats := codec.Analyze(sparse)
+	if stats.Ones != 7 || stats.Zeros != 196 || stats.LongestRun != 85 || stats.Runs != 8 {
+		t.Errorf("unexpected statistics:\n%v", stats)
+	}
+}
+
+func Test_Decode_Truncated(t *testing.T) {
+	encoded := codec.Huffman{}.Encode(text).GetAllBits()
+	truncated := std.NewPhrase(num.NewMeasurement(encoded[:len(encoded)/2]...))
+	if _, err := (codec.Huffman{}).Decode(truncated); err == nil {
+		t.Errorf("expected an error decoding truncated data")
+	}
+}
+
+// corrupt builds an encoded phrase from the provided Elias gamma codes.
+func corrupt(codes []uint) std.Phrase {
+	var bits []num.Bit
+	for _, n := range codes {
+		length := 0
+		for v := n; v > 0; v >>= 1 {
+			length++
+		}
+		bits = append(bits, make([]num.Bit, length-1)...)
+		for i := length - 1; i >= 0; i-- {
+			bits = append(bits, num.Bit((n>>i)&1))
+		}
+	}
+	return std.NewPhrase(num.NewMeasurement(bits...))
+}
+
+func Test_Elias_Decode_CorruptLength(t *testing.T) {
+	// A length header claiming a million bits, followed by a single symbol
+	if _, err := (codec.EliasGamma{}).Decode(corrupt([]uint{1_000_001, 1})); err == nil {
+		t.Errorf("expected an error decoding an oversized length header")
+	}
+
+	// A symbol wider than the remaining bits it claims to hold
+	if _, err := (codec.EliasGamma{Width: 4}).Decode(corrupt([]uint{3, 17})); err == nil {
+		t.Errorf("expected an error decoding an oversized symbol")
+	}
+}
+
+func Test_RLE_Decode_CorruptRun(t *testing.T) {
+	// A leading bit, then a gamma coded run of 2⁴⁰ bits
+	bits := append([]num.Bit{0}, make([]num.Bit, 40)...)
+	bits = append(bits, 1)
+	bits = append(bits, make([]num.Bit, 40)...)
+	if _, err := (codec.RLE{}).Decode(std.NewPhrase(num.NewMeasurement(bits...))); err == nil {
+		t.Errorf("expected an error decoding an oversized run")
+	}
+}
@@ -0,0 +1,144 @@
+// Package entropy provides the pseudo-random source behind every random operation in JanOS.
+//
+// By default, the source is randomly seeded at startup - unless atlas.Seed has been set, in which case every
+// periodically unique set, random name, and shuffle will reproduce exactly from run to run.
+//
+// See Seed, Use, and Global
+package entropy
+
+import (
+	"core/sys/atlas"
+	"math/rand/v2"
+	"sync"
+)
+
+// A Source is a concurrency-safe stream of pseudo-random numbers.
+//
+// See New, NewSeeded, and Global
+type Source struct {
+	gate sync.Mutex
+	rand *rand.Rand
+}
+
+// New creates a Source which draws from the provided rand.Source.
+func New(src rand.Source) *Source {
+	return &Source{rand: rand.New(src)}
+}
+
+// NewSeeded creates a deterministic Source - two sources created from the same seed yield identical streams.
+func NewSeeded(seed uint64) *Source {
+	return New(rand.NewPCG(seed, seed))
+}
+
+// NewRandom creates a randomly seeded Source.
+func NewRandom() *Source {
+	return NewSeeded(rand.Uint64())
+}
+
+// Uint64 returns a pseudo-random 64-bit value.
+func (s *Source) Uint64() uint64 {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+	return s.rand.Uint64()
+}
+
+// Float64 returns a pseudo-random number in the half-open interval [0.0, 1.0).
+func (s *Source) Float64() float64 {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+	return s.rand.Float64()
+}
+
+// IntN returns a pseudo-random number in the half-open interval [0, n).
+//
+// NOTE: This will panic if n <= 0.
+func (s *Source) IntN(n int) int {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+	return s.rand.IntN(n)
+}
+
+// Shuffle pseudo-randomizes the order of n elements using the provided swap function.
+func (s *Source) Shuffle(n int, swap func(i, j int)) {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+	s.rand.Shuffle(n, swap)
+}
+
+/**
+Global
+*/
+
+var gate sync.RWMutex
+var global *Source
+
+func init() {
+	if atlas.Seed != nil {
+		global = NewSeeded(*atlas.Seed)
+		return
+	}
+	global = NewRandom()
+}
+
+// Global returns the Source currently used by every random operation in JanOS.
+func Global() *Source {
+	gate.RLock()
+	defer gate.RUnlock()
+	return global
+}
+
+// Use replaces the global Source, returning the previous one so that it may later be restored.
+//
+// NOTE: This will panic if the provided source is nil.
+func Use(s *Source) *Source {
+	if s == nil {
+		panic("cannot use a nil entropy source")
+	}
+
+	gate.Lock()
+	defer gate.Unlock()
+	previous := global
+	global = s
+	return previous
+}
+
+// Seed replaces the global Source with a deterministic one created from the provided seed - see NewSeeded.
+func Seed(seed uint64) {
+	Use(NewSeeded(seed))
+}
+
+// Scoped calls the provided action while the provided Source is in global use, then restores the previous Source.
+//
+// NOTE: Scopes are not isolated between goroutines - any random operation made elsewhere during the action will
+// also draw from the scoped source.
+func Scoped(s *Source, action func()) {
+	previous := Use(s)
+	defer Use(previous)
+	action()
+}
+
+/**
+Convenience
+*/
+
+// Uint64 returns a pseudo-random 64-bit value from the Global source.
+func Uint64() uint64 {
+	return Global().Uint64()
+}
+
+// Float64 returns a pseudo-random number in the half-open interval [0.0, 1.0) from the Global source.
+func Float64() float64 {
+	return Global().Float64()
+}
+
+// IntN returns a pseudo-random number in the half-open interval [0, n) from the Global source.
+//
+// NOTE: This will panic if n <= 0.
+func IntN(n int) int {
+	return Global().IntN(n)
+}
+
+// Shuffle pseudo-randomizes the order of n elements from the Global source.
+func Shuffle(n int, swap func(i, j int)) {
+	Global().Shuffle(n, swap)
+}
@@ -0,0 +1,52 @@
+package test
+
+import (
+	"core/sys/entropy"
+	"core/sys/num"
+	"core/sys/support"
+	"slices"
+	"testing"
+)
+
+func Test_Entropy_Seed_Reproduces(t *testing.T) {
+	// Seed replaces the global source outright, so restore it for the remaining tests
+	defer entropy.Use(entropy.Global())
+
+	entropy.Seed(42)
+	setA := num.RandomSetWithinRange(64, 0, 1024)
+	shuffleA := support.ShuffleSet([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	floatA := num.Random[float64]()
+
+	entropy.Seed(42)
+	setB := num.RandomSetWithinRange(64, 0, 1024)
+	shuffleB := support.ShuffleSet([]int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	floatB := num.Random[float64]()
+
+	if !slices.Equal(setA, setB) {
+		t.Errorf("expected identical sets, got %v and %v", setA, setB)
+	}
+	if !slices.Equal(shuffleA, shuffleB) {
+		t.Errorf("expected identical shuffles, got %v and %v", shuffleA, shuffleB)
+	}
+	if floatA != floatB {
+		t.Errorf("expected identical floats, got %v and %v", floatA, floatB)
+	}
+}
+
+func Test_Entropy_Scoped(t *testing.T) {
+	var a, b []uint16
+	entropy.Scoped(entropy.NewSeeded(7), func() {
+		a = num.RandomSet[uint16](16)
+	})
+	before := entropy.Global()
+	entropy.Scoped(entropy.NewSeeded(7), func() {
+		b = num.RandomSet[uint16](16)
+	})
+
+	if !slices.Equal(a, b) {
+		t.Errorf("expected identical scoped sets, got %v and %v", a, b)
+	}
+	if entropy.Global() != before {
+		t.Error("expected the previous source to be restored")
+	}
+}
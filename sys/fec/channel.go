@@ -0,0 +1,55 @@
+package fec
+
+import (
+	"core/std"
+	"core/sys/entropy"
+	"core/sys/num"
+	"slices"
+)
+
+// FlipBits simulates a noisy channel by inverting exactly 'count' unique, randomly selected bits of the provided phrase.
+//
+// NOTE: The flipped bit indices are returned in ascending order, allowing them to be compared against a Report.
+//
+// See FlipBits and Noise
+func FlipBits(data std.Phrase, count uint) (std.Phrase, []int) {
+	bits := data.GetAllBits()
+	if count > uint(len(bits)) {
+		count = uint(len(bits))
+	}
+	if count == 0 {
+		return phraseOf(bits), []int{}
+	}
+
+	var indices []int
+	if len(bits) == 1 {
+		indices = []int{0}
+	} else {
+		indices = num.RandomSetWithinRange(count, 0, len(bits)-1)
+	}
+	slices.Sort(indices)
+
+	for _, i := range indices {
+		bits[i] ^= 1
+	}
+	return phraseOf(bits), indices
+}
+
+// Noise simulates a binary symmetric channel by independently inverting every bit of the provided phrase with
+// the provided probability, in the closed interval [0.0, 1.0].
+//
+// NOTE: The flipped bit indices are returned in ascending order, allowing them to be compared against a Report.
+//
+// See FlipBits and Noise
+func Noise(data std.Phrase, probability float64) (std.Phrase, []int) {
+	bits := data.GetAllBits()
+	flipped := make([]int, 0)
+
+	for i := range bits {
+		if entropy.Float64() < probability {
+			bits[i] ^= 1
+			flipped = append(flipped, i)
+		}
+	}
+	return phraseOf(bits), flipped
+}
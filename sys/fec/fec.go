@@ -0,0 +1,58 @@
+// Package fec provides forward error correction codes which encode and decode std.Phrase data at bit granularity.
+//
+// Every decoder returns a Report alongside its output, detailing exactly which received bits were corrected
+// and which codewords held more errors than the code could repair.  Paired with FlipBits or Noise, this allows
+// a noisy channel to be simulated end to end.
+//
+// See Hamming, SECDED, Repetition, and ReedSolomon
+package fec
+
+import (
+	"core/std"
+	"core/sys/num"
+)
+
+// Report describes the outcome of decoding a phrase.
+//
+//	Corrected - The bit indices of the received phrase which were found in error and repaired
+//	Uncorrectable - The indices of the codewords which held more errors than could be repaired
+//
+// NOTE: An uncorrectable codeword is decoded as received, without any correction applied.
+type Report struct {
+	Corrected     []int
+	Uncorrectable []int
+}
+
+// Clean returns true if no errors were found while decoding.
+func (r Report) Clean() bool {
+	return len(r.Corrected) == 0 && len(r.Uncorrectable) == 0
+}
+
+// Recovered returns true if every error found while decoding was successfully corrected.
+func (r Report) Recovered() bool {
+	return len(r.Uncorrectable) == 0
+}
+
+// correct records the provided bit index as corrected - unless it falls within the zero padding of a final codeword,
+// beyond the end of the received bits, in which case there's nothing to report.
+func (r *Report) correct(index int, received int) {
+	if index < received {
+		r.Corrected = append(r.Corrected, index)
+	}
+}
+
+// chunk splits the provided bits into blocks of the provided width, padding the final block with trailing zeros.
+func chunk(bits []num.Bit, width int) [][]num.Bit {
+	out := make([][]num.Bit, 0, (len(bits)+width-1)/width)
+	for i := 0; i < len(bits); i += width {
+		block := make([]num.Bit, width)
+		copy(block, bits[i:min(i+width, len(bits))])
+		out = append(out, block)
+	}
+	return out
+}
+
+// phraseOf creates an anonymous phrase holding the provided bits as a single measurement.
+func phraseOf(bits []num.Bit) std.Phrase {
+	return std.NewPhrase(num.NewMeasurement(bits...))
+}
@@ -0,0 +1,134 @@
+package fec
+
+import (
+	"core/std"
+	"core/sys/num"
+)
+
+// Hamming encodes the provided phrase using a Hamming(7,4) code, which can correct any single bit error per codeword.
+//
+// Each nibble of data is placed into a 7-bit codeword in the classic positional layout -
+//
+//	 position → 1  2  3  4  5  6  7
+//	codeword → p₁ p₂ d₁ p₃ d₂ d₃ d₄
+//
+// NOTE: If the phrase isn't nibble aligned, its final nibble is padded with trailing zeros - which
+// will be present in the decoded output.
+//
+// See Hamming, HammingDecode, SECDED, and SECDEDDecode
+func Hamming(data std.Phrase) std.Phrase {
+	out := make([]num.Bit, 0, (data.BitWidth()+3)/4*7)
+	for _, nibble := range chunk(data.GetAllBits(), 4) {
+		out = append(out, hammingEncode(nibble)...)
+	}
+	return phraseOf(out)
+}
+
+// HammingDecode decodes a phrase encoded by Hamming, correcting a single bit error per codeword.
+//
+// NOTE: A Hamming(7,4) code cannot detect double bit errors - they will be 'corrected' into the wrong nibble.
+// If you need to detect them, please use SECDED.
+//
+// See Hamming, HammingDecode, SECDED, and SECDEDDecode
+func HammingDecode(encoded std.Phrase) (std.Phrase, Report) {
+	var report Report
+	bits := encoded.GetAllBits()
+	out := make([]num.Bit, 0, len(bits)/7*4)
+
+	for i, codeword := range chunk(bits, 7) {
+		if syndrome := hammingSyndrome(codeword); syndrome != 0 {
+			codeword[syndrome-1] ^= 1
+			report.correct(i*7+syndrome-1, len(bits))
+		}
+		out = append(out, hammingData(codeword)...)
+	}
+	return phraseOf(out), report
+}
+
+// SECDED encodes the provided phrase using an extended Hamming(8,4) code, which can correct any single bit error
+// and detect any double bit error per codeword.
+//
+// This is a Hamming(7,4) codeword followed by an overall parity bit -
+//
+//	 position → 1  2  3  4  5  6  7  8
+//	codeword → p₁ p₂ d₁ p₃ d₂ d₃ d₄ p₀
+//
+// NOTE: If the phrase isn't nibble aligned, its final nibble is padded with trailing zeros - which
+// will be present in the decoded output.
+//
+// See Hamming, HammingDecode, SECDED, and SECDEDDecode
+func SECDED(data std.Phrase) std.Phrase {
+	out := make([]num.Bit, 0, (data.BitWidth()+3)/4*8)
+	for _, nibble := range chunk(data.GetAllBits(), 4) {
+		codeword := hammingEncode(nibble)
+		out = append(out, codeword...)
+		out = append(out, parityOf(codeword))
+	}
+	return phraseOf(out)
+}
+
+// SECDEDDecode decodes a phrase encoded by SECDED, correcting single bit errors and reporting double bit errors
+// as uncorrectable.
+//
+// See Hamming, HammingDecode, SECDED, and SECDEDDecode
+func SECDEDDecode(encoded std.Phrase) (std.Phrase, Report) {
+	var report Report
+	bits := encoded.GetAllBits()
+	out := make([]num.Bit, 0, len(bits)/8*4)
+
+	for i, codeword := range chunk(bits, 8) {
+		syndrome := hammingSyndrome(codeword[:7])
+		parity := parityOf(codeword)
+
+		switch {
+		case syndrome == 0 && parity == 1:
+			// The overall parity bit itself was flipped
+			report.correct(i*8+7, len(bits))
+		case syndrome != 0 && parity == 1:
+			codeword[syndrome-1] ^= 1
+			report.correct(i*8+syndrome-1, len(bits))
+		case syndrome != 0 && parity == 0:
+			report.Uncorrectable = append(report.Uncorrectable, i)
+		}
+		out = append(out, hammingData(codeword)...)
+	}
+	return phraseOf(out), report
+}
+
+// hammingEncode places the provided nibble into a Hamming(7,4) codeword.
+func hammingEncode(d []num.Bit) []num.Bit {
+	return []num.Bit{
+		d[0] ^ d[1] ^ d[3],
+		d[0] ^ d[2] ^ d[3],
+		d[0],
+		d[1] ^ d[2] ^ d[3],
+		d[1],
+		d[2],
+		d[3],
+	}
+}
+
+// hammingData extracts the data nibble from a Hamming(7,4) codeword.
+func hammingData(c []num.Bit) []num.Bit {
+	return []num.Bit{c[2], c[4], c[5], c[6]}
+}
+
+// hammingSyndrome returns the 1-based position of the erroneous bit in a Hamming(7,4) codeword, or 0 if it's valid.
+func hammingSyndrome(c []num.Bit) int {
+	var syndrome int
+	for position := 1; position <= 7; position++ {
+		if c[position-1] == 1 {
+			syndrome ^= position
+		}
+	}
+	return syndrome
+}
+
+// parityOf returns the XOR of the provided bits.
+func parityOf(bits []num.Bit) num.Bit {
+	var parity num.Bit
+	for _, b := range bits {
+		parity ^= b
+	}
+	return parity
+}
@@ -0,0 +1,318 @@
+package fec
+
+import (
+	"core/std"
+	"core/sys/num"
+	"fmt"
+)
+
+// ReedSolomon is a systematic Reed–Solomon code over GF(2⁸), using the primitive polynomial x⁸+x⁴+x³+x²+1 (0x11D)
+// and a generator built from consecutive powers of α = 2, starting at α⁰.
+//
+// Each codeword holds up to 255 bytes, of which Parity bytes are check symbols - allowing up to ⌊Parity/2⌋
+// erroneous bytes per codeword to be corrected.  Longer data is split across multiple codewords, with the
+// final codeword shortened to fit.
+//
+// NOTE: If the phrase isn't byte aligned, its final byte is padded with trailing zeros - which will be
+// present in the decoded output.
+//
+// See NewReedSolomon, ReedSolomon.Encode, and ReedSolomon.Decode
+type ReedSolomon struct {
+	Parity uint
+}
+
+// NewReedSolomon creates a Reed–Solomon code with the provided number of parity bytes per codeword, which
+// must be in the closed interval [1, 254].
+func NewReedSolomon(parity uint) ReedSolomon {
+	if parity == 0 || parity > 254 {
+		panic(fmt.Sprintf("invalid Reed–Solomon parity: %d - must be in [1, 254]", parity))
+	}
+	return ReedSolomon{Parity: parity}
+}
+
+// Encode appends Parity check bytes to every codeword of the provided phrase.
+func (rs ReedSolomon) Encode(data std.Phrase) std.Phrase {
+	generator := rs.generator()
+	capacity := 255 - int(rs.Parity)
+
+	bytes := toBytes(data.GetAllBits())
+	out := make([]byte, 0, len(bytes)+(len(bytes)/capacity+1)*int(rs.Parity))
+	for i := 0; i < len(bytes); i += capacity {
+		out = append(out, rsEncode(bytes[i:min(i+capacity, len(bytes))], generator)...)
+	}
+	return std.NewPhrase(num.NewMeasurementOfBytes(out...))
+}
+
+// Decode corrects and strips the check bytes from every codeword of a phrase encoded by Encode.
+//
+// NOTE: Corrections are reported at bit granularity, so a single repaired byte may yield several corrected bits.
+func (rs ReedSolomon) Decode(encoded std.Phrase) (std.Phrase, Report) {
+	var report Report
+	bits := encoded.GetAllBits()
+	if len(bits)%8 != 0 {
+		panic(fmt.Sprintf("a Reed–Solomon codeword must be byte aligned - got %d bits", len(bits)))
+	}
+
+	bytes := toBytes(bits)
+	if remainder := len(bytes) % 255; remainder != 0 && remainder <= int(rs.Parity) {
+		panic(fmt.Sprintf("the final codeword of %d bytes cannot hold %d parity bytes", remainder, rs.Parity))
+	}
+
+	out := make([]byte, 0, len(bytes))
+	for i, block := 0, 0; i < len(bytes); i, block = i+255, block+1 {
+		received := bytes[i:min(i+255, len(bytes))]
+		corrected, ok := rsDecode(received, int(rs.Parity))
+		if !ok {
+			report.Uncorrectable = append(report.Uncorrectable, block)
+			corrected = received
+		}
+
+		for ii := range corrected {
+			delta := corrected[ii] ^ received[ii]
+			for bit := 0; bit < 8; bit++ {
+				if delta&(0x80>>bit) != 0 {
+					report.Corrected = append(report.Corrected, (i+ii)*8+bit)
+				}
+			}
+		}
+		out = append(out, corrected[:len(corrected)-int(rs.Parity)]...)
+	}
+	return std.NewPhrase(num.NewMeasurementOfBytes(out...)), report
+}
+
+// generator builds the generator polynomial ∏(x - αⁱ) for i in [0, Parity).
+func (rs ReedSolomon) generator() []byte {
+	g := []byte{1}
+	for i := 0; i < int(rs.Parity); i++ {
+		g = gfPolyMul(g, []byte{1, gfPow(2, i)})
+	}
+	return g
+}
+
+// rsEncode appends the remainder of message·xⁿ ÷ generator to the message.
+func rsEncode(message []byte, generator []byte) []byte {
+	out := make([]byte, len(message)+len(generator)-1)
+	copy(out, message)
+
+	for i := range message {
+		if coefficient := out[i]; coefficient != 0 {
+			for j := 1; j < len(generator); j++ {
+				out[i+j] ^= gfMul(generator[j], coefficient)
+			}
+		}
+	}
+	copy(out, message)
+	return out
+}
+
+// rsDecode corrects the provided codeword, returning false if it holds more errors than can be corrected.
+func rsDecode(received []byte, parity int) ([]byte, bool) {
+	codeword := make([]byte, len(received))
+	copy(codeword, received)
+
+	syndromes := rsSyndromes(codeword, parity)
+	if isZero(syndromes) {
+		return codeword, true
+	}
+
+	// NOTE: The syndromes are prefixed with a zero coefficient, so the 𝑖th syndrome lives at index 𝑖+1
+	syndromes = append([]byte{0}, syndromes...)
+
+	// Berlekamp–Massey
+	locator, previous := []byte{1}, []byte{1}
+	for i := 1; i <= parity; i++ {
+		delta := syndromes[i]
+		for j := 1; j < len(locator); j++ {
+			delta ^= gfMul(locator[len(locator)-1-j], syndromes[i-j])
+		}
+
+		previous = append(previous, 0)
+		if delta != 0 {
+			if len(previous) > len(locator) {
+				next := gfPolyScale(previous, delta)
+				previous = gfPolyScale(locator, gfInverse(delta))
+				locator = next
+			}
+			locator = gfPolyAdd(locator, gfPolyScale(previous, delta))
+		}
+	}
+	for len(locator) > 0 && locator[0] == 0 {
+		locator = locator[1:]
+	}
+
+	errors := len(locator) - 1
+	if errors*2 > parity {
+		return nil, false
+	}
+
+	// Chien search
+	reversed := make([]byte, len(locator))
+	for i := range locator {
+		reversed[i] = locator[len(locator)-1-i]
+	}
+	positions := make([]int, 0, errors)
+	for i := 0; i < len(codeword); i++ {
+		if gfPolyEval(reversed, gfPow(2, i)) == 0 {
+			positions = append(positions, len(codeword)-1-i)
+		}
+	}
+	if len(positions) != errors {
+		return nil, false
+	}
+
+	// Forney
+	coefficients := make([]int, len(positions))
+	errata := []byte{1}
+	for i, p := range positions {
+		coefficients[i] = len(codeword) - 1 - p
+		errata = gfPolyMul(errata, gfPolyAdd([]byte{1}, []byte{gfPow(2, coefficients[i]), 0}))
+	}
+
+	reversedSyndromes := make([]byte, len(syndromes))
+	for i := range syndromes {
+		reversedSyndromes[i] = syndromes[len(syndromes)-1-i]
+	}
+	product := gfPolyMul(reversedSyndromes, errata)
+	evaluator := product[max(0, len(product)-len(errata)):]
+
+	x := make([]byte, len(coefficients))
+	for i, c := range coefficients {
+		x[i] = gfPow(2, c)
+	}
+
+	for i, xi := range x {
+		inverse := gfInverse(xi)
+
+		derivative := byte(1)
+		for j := range x {
+			if j != i {
+				derivative = gfMul(derivative, 1^gfMul(inverse, x[j]))
+			}
+		}
+		if derivative == 0 {
+			return nil, false
+		}
+
+		y := gfMul(xi, gfPolyEval(evaluator, inverse))
+		codeword[positions[i]] ^= gfDiv(y, derivative)
+	}
+
+	if !isZero(rsSyndromes(codeword, parity)) {
+		return nil, false
+	}
+	return codeword, true
+}
+
+// rsSyndromes evaluates the codeword at each root of the generator polynomial.
+func rsSyndromes(codeword []byte, parity int) []byte {
+	syndromes := make([]byte, parity)
+	for i := range syndromes {
+		syndromes[i] = gfPolyEval(codeword, gfPow(2, i))
+	}
+	return syndromes
+}
+
+func isZero(values []byte) bool {
+	for _, v := range values {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// toBytes packs the provided bits into bytes, padding the final byte with trailing zeros.
+func toBytes(bits []num.Bit) []byte {
+	out := make([]byte, (len(bits)+7)/8)
+	for i, b := range bits {
+		out[i/8] |= byte(b) << (7 - i%8)
+	}
+	return out
+}
+
+/**
+GF(2⁸) Arithmetic
+*/
+
+var gfExp, gfLog = func() ([512]byte, [256]int) {
+	var exp [512]byte
+	var log [256]int
+
+	x := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(x)
+		log[x] = i
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11D
+		}
+	}
+	for i := 255; i < 512; i++ {
+		exp[i] = exp[i-255]
+	}
+	return exp, log
+}()
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[gfLog[a]+gfLog[b]]
+}
+
+func gfDiv(a, b byte) byte {
+	if b == 0 {
+		panic("cannot divide by zero in GF(2⁸)")
+	}
+	if a == 0 {
+		return 0
+	}
+	return gfExp[(gfLog[a]+255-gfLog[b])%255]
+}
+
+func gfPow(a byte, power int) byte {
+	return gfExp[((gfLog[a]*power)%255+255)%255]
+}
+
+func gfInverse(a byte) byte {
+	return gfExp[255-gfLog[a]]
+}
+
+// NOTE: Polynomials are ordered from the highest degree coefficient to the lowest.
+
+func gfPolyScale(p []byte, x byte) []byte {
+	out := make([]byte, len(p))
+	for i := range p {
+		out[i] = gfMul(p[i], x)
+	}
+	return out
+}
+
+func gfPolyAdd(p, q []byte) []byte {
+	out := make([]byte, max(len(p), len(q)))
+	for i := range p {
+		out[i+len(out)-len(p)] = p[i]
+	}
+	for i := range q {
+		out[i+len(out)-len(q)] ^= q[i]
+	}
+	return out
+}
+
+func gfPolyMul(p, q []byte) []byte {
+	out := make([]byte, len(p)+len(q)-1)
+	for j := range q {
+		for i := range p {
+			out[i+j] ^= gfMul(p[i], q[j])
+		}
+	}
+	return out
+}
+
+func gfPolyEval(p []byte, x byte) byte {
+	y := p[0]
+	for i := 1; i < len(p); i++ {
+		y = gfMul(y, x) ^ p[i]
+	}
+	return y
+}
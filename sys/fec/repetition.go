@@ -0,0 +1,69 @@
+package fec
+
+import (
+	"core/std"
+	"core/sys/num"
+	"fmt"
+)
+
+// Repetition encodes the provided phrase by repeating every bit n times.
+//
+// NOTE: An odd n is recommended - with an even n, a codeword split evenly between ones and zeros cannot be
+// resolved and will be reported as uncorrectable.
+//
+// See Repetition and RepetitionDecode
+func Repetition(data std.Phrase, n uint) std.Phrase {
+	if n == 0 {
+		panic("cannot repeat bits zero times")
+	}
+
+	out := make([]num.Bit, 0, data.BitWidth()*n)
+	for _, b := range data.GetAllBits() {
+		for i := uint(0); i < n; i++ {
+			out = append(out, b)
+		}
+	}
+	return phraseOf(out)
+}
+
+// RepetitionDecode decodes a phrase encoded by Repetition using a majority vote across each codeword of n bits.
+//
+// See Repetition and RepetitionDecode
+func RepetitionDecode(encoded std.Phrase, n uint) (std.Phrase, Report) {
+	if n == 0 {
+		panic("cannot repeat bits zero times")
+	}
+
+	bits := encoded.GetAllBits()
+	if uint(len(bits))%n != 0 {
+		panic(fmt.Sprintf("a %d bit phrase cannot hold whole codewords of %d bits", len(bits), n))
+	}
+
+	var report Report
+	out := make([]num.Bit, 0, uint(len(bits))/n)
+
+	for i, codeword := range chunk(bits, int(n)) {
+		var ones uint
+		for _, b := range codeword {
+			ones += uint(b)
+		}
+
+		if ones*2 == n {
+			report.Uncorrectable = append(report.Uncorrectable, i)
+			out = append(out, codeword[0])
+			continue
+		}
+
+		majority := num.Bit(0)
+		if ones*2 > n {
+			majority = 1
+		}
+		for ii, b := range codeword {
+			if b != majority {
+				report.Corrected = append(report.Corrected, i*int(n)+ii)
+			}
+		}
+		out = append(out, majority)
+	}
+	return phraseOf(out), report
+}
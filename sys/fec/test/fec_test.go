@@ -0,0 +1,114 @@
+package test
+
+import (
+	"core/std"
+	"core/sys/fec"
+	"core/sys/num"
+	"slices"
+	"testing"
+)
+
+var data = std.NewPhrase(num.NewMeasurementOfBytes([]byte("Hello, World!")...))
+
+func Test_Hamming_CorrectsSingleErrors(t *testing.T) {
+	encoded := fec.Hamming(data)
+	bits := encoded.GetAllBits()
+
+	// Flip one bit within every other codeword
+	expected := make([]int, 0)
+	for i := 3; i < len(bits); i += 14 {
+		bits[i] ^= 1
+		expected = append(expected, i)
+	}
+
+	decoded, report := fec.HammingDecode(std.NewPhrase(num.NewMeasurement(bits...)))
+	if decoded.String() != data.String() {
+		t.Errorf("decoded %v, want %v", decoded.String(), data.String())
+	}
+	if !slices.Equal(report.Corrected, expected) {
+		t.Errorf("corrected %v, want %v", report.Corrected, expected)
+	}
+}
+
+func Test_SECDED_DetectsDoubleErrors(t *testing.T) {
+	encoded := fec.SECDED(data)
+	bits := encoded.GetAllBits()
+	bits[0] ^= 1
+	bits[9] ^= 1
+	bits[10] ^= 1
+
+	_, report := fec.SECDEDDecode(std.NewPhrase(num.NewMeasurement(bits...)))
+	if !slices.Equal(report.Corrected, []int{0}) {
+		t.Errorf("corrected %v, want [0]", report.Corrected)
+	}
+	if !slices.Equal(report.Uncorrectable, []int{1}) {
+		t.Errorf("uncorrectable %v, want [1]", report.Uncorrectable)
+	}
+}
+
+func Test_Repetition(t *testing.T) {
+	noisy, flipped := fec.FlipBits(fec.Repetition(data, 3), 1)
+
+	decoded, report := fec.RepetitionDecode(noisy, 3)
+	if decoded.String() != data.String() {
+		t.Errorf("decoded %v, want %v", decoded.String(), data.String())
+	}
+	if !slices.Equal(report.Corrected, flipped) {
+		t.Errorf("corrected %v, want %v", report.Corrected, flipped)
+	}
+}
+
+func Test_ReedSolomon(t *testing.T) {
+	rs := fec.NewReedSolomon(8)
+	encoded := rs.Encode(data)
+
+	if encoded.BitWidth() != data.BitWidth()+64 {
+		t.Fatalf("expected %d bits, got %d", data.BitWidth()+64, encoded.BitWidth())
+	}
+
+	// Corrupt four whole bytes - the most eight parity bytes can repair
+	bits := encoded.GetAllBits()
+	for _, i := range []int{0, 1, 7, 42, 43, 44, 45, 46, 47, 48, 49, 100} {
+		bits[i] ^= 1
+	}
+
+	decoded, report := rs.Decode(std.NewPhrase(num.NewMeasurement(bits...)))
+	if !report.Recovered() {
+		t.Fatalf("failed to recover from four byte errors")
+	}
+	if decoded.String() != data.String() {
+		t.Errorf("decoded %v, want %v", decoded.String(), data.String())
+	}
+	if len(report.Corrected) != 12 {
+		t.Errorf("corrected %v, want 12 bits", report.Corrected)
+	}
+
+	// A fifth byte error exceeds the code's capability
+	bits[120] ^= 1
+	_, report = rs.Decode(std.NewPhrase(num.NewMeasurement(bits...)))
+	if report.Recovered() {
+		t.Errorf("five byte errors were reported as recovered")
+	}
+}
+
+func Test_HammingDecode_TruncatedCodeword(t *testing.T) {
+	// The final bit of the 0001 codeword was lost, so the padding 'corrects' it back into place
+	received := std.NewPhrase(num.NewMeasurement(1, 1, 0, 1, 0, 0))
+
+	decoded, report := fec.HammingDecode(received)
+	if decoded.String() != "0001" {
+		t.Errorf("decoded %v, want 0001", decoded.String())
+	}
+	if len(report.Corrected) != 0 {
+		t.Errorf("expected no corrections within the received bits, got %v", report.Corrected)
+	}
+}
+
+func Test_Noise_Certainty(t *testing.T) {
+	if _, flipped := fec.Noise(data, 1.0); len(flipped) != int(data.BitWidth()) {
+		t.Errorf("expected a probability of 1.0 to flip all %d bits, flipped %d", data.BitWidth(), len(flipped))
+	}
+	if _, flipped := fec.Noise(data, 0.0); len(flipped) != 0 {
+		t.Errorf("expected a probability of 0.0 to flip nothing, flipped %v", flipped)
+	}
+}
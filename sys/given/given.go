@@ -2,13 +2,13 @@ package given
 
 import (
 	"core/enum/gender"
+	"core/sys/entropy"
 	"core/sys/given/format"
 	"core/sys/id"
 	log "core/sys/log"
 	_ "embed"
 	"encoding/csv"
 	"fmt"
-	"math/rand"
 	"regexp"
 	"strings"
 	"sync"
@@ -199,9 +199,9 @@ var nonAlphaRegex = regexp.MustCompile(`^[A-Za-z]+$`)
 func random[T format.Format]() Name {
 	switch any(T("")).(type) {
 	case format.NameDB:
-		return nameDB[rand.Intn(len(nameDB))]
+		return nameDB[entropy.IntN(len(nameDB))]
 	case format.SurnameDB:
-		return Name{Name: surnameDB[rand.Intn(len(surnameDB))]}
+		return Name{Name: surnameDB[entropy.IntN(len(surnameDB))]}
 	case format.Tiny:
 		for {
 			name := random[format.NameDB]()
@@ -211,8 +211,8 @@ func random[T format.Format]() Name {
 			}
 		}
 	case format.Multi, format.Default: // NOTE: Default can be moved between case statements
-		name := nameDB[rand.Intn(len(nameDB))]
-		last := surnameDB[rand.Intn(len(surnameDB))]
+		name := nameDB[entropy.IntN(len(nameDB))]
+		last := surnameDB[entropy.IntN(len(surnameDB))]
 		name.Name += " " + last
 		return name
 	default:
@@ -0,0 +1,270 @@
+package num
+
+import (
+	"core/enum/sign"
+	"core/sys/atlas"
+	"core/sys/num/internal"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// AlignOptions configures how ToStringAlignedWith lines up its operands.
+//
+//	Base   - the base to print every operand in, or base₁₀ if zero
+//	Format - the grouping, decimal mark, and sign style to print with, or FormatPlain if it holds no decimal mark
+//	NaN    - the placeholder printed in place of a NaN, or a panic if empty
+//	Inf    - the placeholder printed in place of an Inf (whose sign is printed as normal), or a panic if empty
+//
+// See ToStringAligned, ToStringAlignedWith, and NumberFormat
+type AlignOptions struct {
+	Base   uint16
+	Format NumberFormat
+	NaN    string
+	Inf    string
+}
+
+// ToStringAligned aligns the provided operands in base₁₀ - see ToStringAlignedWith.
+func ToStringAligned(operands ...any) []string {
+	return ToStringAlignedWith(AlignOptions{}, operands...)
+}
+
+// ToStringAlignedWith prints the provided operands such that their signs, whole parts, decimal marks, and fractional
+// parts all share the same columns.  Any FilterOperands compatible operand may be provided.
+//
+//	"~ 01.41421356"   ← √2 - irrationals hold a tilde column
+//	" -00.‾3      "   ← -⅓ - periodic values keep their overscore
+//	"  12.50000000"   ← terminating values are padded with zeros
+//
+// Whole parts are left-padded with zeros to the widest operand, while terminating fractional parts are right-padded
+// with zeros.  Periodic and irrational fractional parts are instead right-padded with spaces, as a trailing zero would
+// change or falsely extend their value.  The decimal mark column is omitted if no operand holds a fractional part, and
+// NaN or Inf placeholders are right-aligned within the whole part's column.
+//
+// NOTE: Bases above 16 follow the spaced-placeholder convention of see.PrintingNumbers.
+//
+// NOTE: This will panic if provided a complex operand, or a NaN or Inf without a configured placeholder.
+func ToStringAlignedWith(options AlignOptions, operands ...any) []string {
+	b := uint16(10)
+	if options.Base > 0 {
+		b = PanicIfInvalidBase(options.Base)
+	}
+	f := options.Format
+	if len(f.DecimalMark) == 0 {
+		f = FormatPlain
+	}
+	f.sanityCheck()
+
+	rows := make([]alignedRow, len(operands))
+	var widestWhole, widestRegion int
+	for i, op := range operands {
+		rows[i] = alignedRowOf(op, b, options)
+		if rows[i].placeholder == "" {
+			widestWhole = max(widestWhole, len(rows[i].whole))
+			widestRegion = max(widestRegion, rows[i].regionWidth())
+		}
+	}
+
+	// Build each column, padding the digits before grouping so the separators share the same columns
+	const (
+		prefix = iota
+		opening
+		whole
+		mark
+		region
+		closing
+	)
+	columns := make([][6]string, len(rows))
+	for i, row := range rows {
+		switch {
+		case row.negative && f.Sign == sign.Accounting:
+			columns[i][opening], columns[i][closing] = "(", ")"
+		case row.negative:
+			columns[i][opening] = "-"
+		case f.Sign == sign.Explicit && !row.zero():
+			columns[i][opening] = "+"
+		}
+		if row.approximate {
+			columns[i][prefix] = "~"
+		}
+		if row.placeholder != "" {
+			columns[i][whole] = row.placeholder
+			continue
+		}
+
+		columns[i][whole] = f.join(f.group(printDigits(padDigits(row.whole, uint(widestWhole)), b)), nil, nil, b)
+		if widestRegion > 0 {
+			columns[i][mark] = f.DecimalMark
+		}
+		if row.approximate || len(row.periodic) > 0 {
+			placeholders := printDigits(row.fractional, b)
+			if len(row.periodic) > 0 {
+				placeholders = append(append(placeholders, "‾"), printDigits(row.periodic, b)...)
+			}
+			columns[i][region] = joinDigits(placeholders, b)
+		} else {
+			padded := append(append([]byte{}, row.fractional...), make([]byte, widestRegion-len(row.fractional))...)
+			columns[i][region] = joinDigits(printDigits(padded, b), b)
+		}
+	}
+
+	// Pad every column to its widest value - omitting any column which holds nothing
+	var widths [6]int
+	for _, c := range columns {
+		for j, s := range c {
+			widths[j] = max(widths[j], utf8.RuneCountInString(s))
+		}
+	}
+	widths[opening] = max(widths[opening], 1)
+
+	out := make([]string, len(columns))
+	for i, c := range columns {
+		components := make([]string, 0, len(c))
+		for j, s := range c {
+			if widths[j] == 0 {
+				continue
+			}
+			components = append(components, padRunes(s, widths[j], j >= mark))
+		}
+		out[i] = joinDigits(components, b)
+	}
+	return out
+}
+
+// alignedRow holds the components of a single operand being aligned.
+type alignedRow struct {
+	approximate bool
+	negative    bool
+	whole       []byte
+	fractional  []byte
+	periodic    []byte
+	placeholder string
+}
+
+// regionWidth returns the number of placeholders to the right of the decimal mark - counting the overscore.
+func (row alignedRow) regionWidth() int {
+	if len(row.periodic) > 0 {
+		return len(row.fractional) + 1 + len(row.periodic)
+	}
+	return len(row.fractional)
+}
+
+// zero returns whether every placeholder of the row is zero.
+func (row alignedRow) zero() bool {
+	if row.placeholder != "" {
+		return false
+	}
+	for _, digits := range [][]byte{row.whole, row.fractional, row.periodic} {
+		for _, d := range digits {
+			if d != 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// alignedRowOf breaks the provided operand down into its components in the provided base.
+func alignedRowOf(operand any, base uint16, options AlignOptions) alignedRow {
+	switch operand.(type) {
+	case complex64, complex128:
+		panic("cannot align complex numbers")
+	}
+
+	if IsNaN(operand) {
+		if options.NaN == "" {
+			panic("cannot align NaN")
+		}
+		return alignedRow{placeholder: options.NaN}
+	}
+	if inf, negative := IsInf(operand); inf {
+		if options.Inf == "" {
+			panic("cannot align Inf")
+		}
+		return alignedRow{negative: negative, placeholder: options.Inf}
+	}
+
+	var row alignedRow
+	switch typed := FilterOperands(base, operand)[0].(type) {
+	case Natural:
+		row.whole = intToDigits(typed.toInt(), base)
+	case Measurement:
+		row.whole = intToDigits(measurementToInt(typed), base)
+	case Realization:
+		row = alignedRow{typed.Irrational, typed.Negative, typed.Whole, typed.Fractional, typed.Periodic, ""}
+	case Realized:
+		row = alignedRowOfRealized(&typed, base)
+	case string:
+		// Strings provided directly are in the requested base, while every other type is filtered into base₁₀
+		from := base
+		if _, ok := operand.(string); !ok {
+			from = 10
+		}
+		row.approximate, row.negative, row.whole, row.fractional, row.periodic = FormatPlain.parse(typed, from)
+		if from != base {
+			x := digitsToRat(row.whole, row.fractional, row.periodic, from)
+			realization := expandRat(x, base, atlas.Precision)
+			row.approximate = row.approximate || realization.Irrational
+			row.whole, row.fractional, row.periodic = realization.Whole, realization.Fractional, realization.Periodic
+		}
+	default:
+		panic(fmt.Errorf("cannot align %T", typed))
+	}
+
+	if len(row.whole) == 0 {
+		row.whole = []byte{0}
+	}
+	// NOTE: This ensures we don't get a '-0' in the output
+	row.negative = row.negative && !row.zero()
+	return row
+}
+
+// alignedRowOfRealized breaks the provided realized number down into its components in the provided base.
+func alignedRowOfRealized(r *Realized, base uint16) alignedRow {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
+	if base == r.base {
+		whole, fractional, periodic := r.Digits()
+		return alignedRow{r.irrational, r.negative, whole, fractional, periodic, ""}
+	}
+
+	x := realizedToRat(r)
+	precision := atlas.Precision
+	if r.precision != nil {
+		precision = *r.precision
+	}
+	realization := expandRat(new(big.Rat).Abs(x), base, precision)
+	return alignedRow{r.irrational || realization.Irrational, x.Sign() < 0, realization.Whole, realization.Fractional, realization.Periodic, ""}
+}
+
+// printDigits prints each of the provided digits as a placeholder in the provided base.
+func printDigits(digits []byte, base uint16) []string {
+	out := make([]string, len(digits))
+	for i, d := range digits {
+		out[i] = internal.PrintDigit(d, base)
+	}
+	return out
+}
+
+// joinDigits joins printed components following the spaced-placeholder convention.
+func joinDigits(components []string, base uint16) string {
+	if base > 16 {
+		return strings.Join(components, " ")
+	}
+	return strings.Join(components, "")
+}
+
+// padRunes pads the provided string with spaces to the provided width, on the right if trailing or else the left.
+func padRunes(s string, width int, trailing bool) string {
+	padding := width - utf8.RuneCountInString(s)
+	if padding <= 0 {
+		return s
+	}
+	if trailing {
+		return s + strings.Repeat(" ", padding)
+	}
+	return strings.Repeat(" ", padding) + s
+}
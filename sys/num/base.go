@@ -3,6 +3,7 @@ package num
 import (
 	"core/sys/atlas"
 	"core/sys/num/internal"
+	"core/sys/support"
 	"errors"
 	"fmt"
 	"strconv"
@@ -27,7 +28,7 @@ func (_base) StringToString(source string, sourceBase uint16, targetBase uint16)
 
 	out := make([]string, len(digits))
 	for i, d := range digits {
-		out[i] = internal.PrintDigit(d)
+		out[i] = internal.PrintDigit(d, targetBase)
 	}
 
 	if negative {
@@ -56,11 +57,11 @@ func (_base) DigitsToString(source []byte, sourceBase uint16, targetBase uint16)
 	digits := make([]string, len(source))
 
 	for i, d := range source {
-		digits[i] = internal.PrintDigit(d)
+		digits[i] = internal.PrintDigit(d, sourceBase)
 	}
 
 	var sourceStr string
-	if targetBase > 16 {
+	if sourceBase > 16 {
 		sourceStr = strings.Join(digits, " ")
 	} else {
 		sourceStr = strings.Join(digits, "")
@@ -73,11 +74,11 @@ func (_base) DigitsToDigits(source []byte, sourceBase uint16, targetBase uint16)
 	digits := make([]string, len(source))
 
 	for i, d := range source {
-		digits[i] = internal.PrintDigit(d)
+		digits[i] = internal.PrintDigit(d, sourceBase)
 	}
 
 	var sourceStr string
-	if targetBase > 16 {
+	if sourceBase > 16 {
 		sourceStr = strings.Join(digits, " ")
 	} else {
 		sourceStr = strings.Join(digits, "")
@@ -193,17 +194,7 @@ func findPeriodic(digits []byte) (pre, period []byte, repeats int) {
 	}
 
 	// KMP prefix function on rev
-	pi := make([]int, n)
-	for i := 1; i < n; i++ {
-		j := pi[i-1]
-		for j > 0 && rev[i] != rev[j] {
-			j = pi[j-1]
-		}
-		if rev[i] == rev[j] {
-			j++
-		}
-		pi[i] = j
-	}
+	pi := support.PrefixFunction(rev)
 
 	// Scan for the longest suffix (largest L) that is an exact repetition of its minimal period,
 	// covers at least 'threshold' digits, and repeats at least twice.
@@ -0,0 +1,487 @@
+package num
+
+import (
+	"core/sys/atlas"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// A RealizedComplex is an arbitrary precision complex number composed of a pair of Realized parts.
+//
+//	𝑧 = 𝑎 + 𝑏𝑖
+//
+// Arithmetic is performed exactly against the calculated digits of each part.  If either operand holds an irrational
+// part, every part it influences is realized as an irrational to atlas.Precision placeholders - just as a Realized.
+//
+// NOTE: A RealizedComplex is immutable - every operation returns a new value.
+//
+// See ParseComplex, ComplexOf, and FromPolar
+type RealizedComplex struct {
+	real      *Realized
+	imaginary *Realized
+}
+
+// ParseComplex creates a static complex number from a real and imaginary operand, each of which are parsed as a
+// Realized in the provided base - or base₁₀ if omitted.
+//
+// NOTE: Realized operands are used as-is, and their base is retained.
+func ParseComplex(real, imaginary any, base ...uint16) RealizedComplex {
+	return RealizedComplex{
+		real:      complexPart(real, base...),
+		imaginary: complexPart(imaginary, base...),
+	}
+}
+
+// ComplexOf creates a static complex number from a primitive complex value in the provided base, or base₁₀ if omitted.
+//
+// NOTE: Both parts are converted from their exact binary value - see FromRat.
+func ComplexOf(c complex128, base ...uint16) RealizedComplex {
+	re, im := FromRat(ToRat(real(c)), base...), FromRat(ToRat(imag(c)), base...)
+	return RealizedComplex{real: &re, imaginary: &im}
+}
+
+// FromPolar creates a complex number from its magnitude and angle in radians.
+//
+//	𝑧 = 𝑟·(cos θ + 𝑖·sin θ)
+//
+// NOTE: Unless the angle is zero, both parts are irrational.
+func FromPolar(magnitude, angle any, base ...uint16) RealizedComplex {
+	r, rIrrational := complexRat(complexPart(magnitude, base...))
+	theta, _ := complexRat(complexPart(angle, base...))
+	b := PanicIfInvalidBase(base...)
+
+	if theta.Sign() == 0 {
+		zero := FromRat(new(big.Rat), b)
+		re := realizedOfRat(r, b, rIrrational)
+		return RealizedComplex{real: &re, imaginary: &zero}
+	}
+
+	bits := transcendentalBits(b)
+	sin, cos := sinCos(new(big.Float).SetPrec(bits).SetRat(theta))
+	radius := new(big.Float).SetPrec(bits).SetRat(r)
+
+	re := realizedOfFloat(cos.Mul(cos, radius), b)
+	im := realizedOfFloat(sin.Mul(sin, radius), b)
+	return RealizedComplex{real: &re, imaginary: &im}
+}
+
+// Real returns the real part.
+//
+// NOTE: The part is shared with this complex number - please do not mutate it.
+func (z RealizedComplex) Real() *Realized {
+	z.sanityCheck()
+	return z.real
+}
+
+// Imaginary returns the imaginary part.
+//
+// NOTE: The part is shared with this complex number - please do not mutate it.
+func (z RealizedComplex) Imaginary() *Realized {
+	z.sanityCheck()
+	return z.imaginary
+}
+
+// Base returns the base of the real part, which every result is realized in.
+func (z RealizedComplex) Base() uint16 {
+	z.sanityCheck()
+	return z.real.Base()
+}
+
+/**
+Arithmetic
+*/
+
+// Add returns the sum of this and the provided complex number.
+//
+//	(𝑎 + 𝑏𝑖) + (𝑐 + 𝑑𝑖) = (𝑎 + 𝑐) + (𝑏 + 𝑑)𝑖
+func (z RealizedComplex) Add(operand RealizedComplex) RealizedComplex {
+	p := z.parts(operand)
+	return z.derive(new(big.Rat).Add(p.a, p.c), new(big.Rat).Add(p.b, p.d), p.ai || p.ci, p.bi || p.di)
+}
+
+// Sub returns the difference of this and the provided complex number.
+//
+//	(𝑎 + 𝑏𝑖) - (𝑐 + 𝑑𝑖) = (𝑎 - 𝑐) + (𝑏 - 𝑑)𝑖
+func (z RealizedComplex) Sub(operand RealizedComplex) RealizedComplex {
+	p := z.parts(operand)
+	return z.derive(new(big.Rat).Sub(p.a, p.c), new(big.Rat).Sub(p.b, p.d), p.ai || p.ci, p.bi || p.di)
+}
+
+// Mul returns the product of this and the provided complex number.
+//
+//	(𝑎 + 𝑏𝑖)(𝑐 + 𝑑𝑖) = (𝑎𝑐 - 𝑏𝑑) + (𝑎𝑑 + 𝑏𝑐)𝑖
+func (z RealizedComplex) Mul(operand RealizedComplex) RealizedComplex {
+	p := z.parts(operand)
+	re := new(big.Rat).Sub(new(big.Rat).Mul(p.a, p.c), new(big.Rat).Mul(p.b, p.d))
+	im := new(big.Rat).Add(new(big.Rat).Mul(p.a, p.d), new(big.Rat).Mul(p.b, p.c))
+	reIrrational := irrationalProduct(p.a, p.ai, p.c, p.ci) || irrationalProduct(p.b, p.bi, p.d, p.di)
+	imIrrational := irrationalProduct(p.a, p.ai, p.d, p.di) || irrationalProduct(p.b, p.bi, p.c, p.ci)
+	return z.derive(re, im, reIrrational, imIrrational)
+}
+
+// Div returns the quotient of this and the provided complex number.
+//
+//	(𝑎 + 𝑏𝑖) / (𝑐 + 𝑑𝑖) = ((𝑎𝑐 + 𝑏𝑑) + (𝑏𝑐 - 𝑎𝑑)𝑖) / (𝑐² + 𝑑²)
+//
+// NOTE: This will panic if the divisor is zero.
+func (z RealizedComplex) Div(operand RealizedComplex) RealizedComplex {
+	p := z.parts(operand)
+
+	denominator := new(big.Rat).Add(new(big.Rat).Mul(p.c, p.c), new(big.Rat).Mul(p.d, p.d))
+	if denominator.Sign() == 0 {
+		panic("cannot divide by zero")
+	}
+
+	re := new(big.Rat).Add(new(big.Rat).Mul(p.a, p.c), new(big.Rat).Mul(p.b, p.d))
+	im := new(big.Rat).Sub(new(big.Rat).Mul(p.b, p.c), new(big.Rat).Mul(p.a, p.d))
+	reIrrational := irrationalProduct(p.a, p.ai, p.c, p.ci) || irrationalProduct(p.b, p.bi, p.d, p.di)
+	imIrrational := irrationalProduct(p.b, p.bi, p.c, p.ci) || irrationalProduct(p.a, p.ai, p.d, p.di)
+
+	// An exact zero numerator stays exact, whatever the denominator
+	irrationalDenominator := p.ci || p.di
+	reIrrational = reIrrational || (irrationalDenominator && re.Sign() != 0)
+	imIrrational = imIrrational || (irrationalDenominator && im.Sign() != 0)
+	return z.derive(re.Quo(re, denominator), im.Quo(im, denominator), reIrrational, imIrrational)
+}
+
+// Conjugate returns the complex conjugate.
+//
+//	(𝑎 + 𝑏𝑖)* = 𝑎 - 𝑏𝑖
+func (z RealizedComplex) Conjugate() RealizedComplex {
+	z.sanityCheck()
+	a, aIrrational := complexRat(z.real)
+	b, bIrrational := complexRat(z.imaginary)
+	base := z.Base()
+
+	re := realizedOfRat(a, base, aIrrational)
+	im := realizedOfRat(b.Neg(b), base, bIrrational)
+	return RealizedComplex{real: &re, imaginary: &im}
+}
+
+/**
+Polar Form
+*/
+
+// Magnitude returns the distance of the complex number from the origin.
+//
+//	|𝑎 + 𝑏𝑖| = √(𝑎² + 𝑏²)
+//
+// The root is exact whenever 𝑎² + 𝑏² is the square of a rational - such as |3 + 4𝑖| = 5.  Otherwise, it is realized
+// as an irrational to atlas.Precision placeholders.
+func (z RealizedComplex) Magnitude() Realized {
+	z.sanityCheck()
+	a, aIrrational := complexRat(z.real)
+	b, bIrrational := complexRat(z.imaginary)
+	base := z.Base()
+
+	square := new(big.Rat).Add(new(big.Rat).Mul(a, a), new(big.Rat).Mul(b, b))
+	if !aIrrational && !bIrrational {
+		numerator := new(big.Int).Sqrt(square.Num())
+		denominator := new(big.Int).Sqrt(square.Denom())
+		root := new(big.Rat).SetFrac(numerator, denominator)
+		if new(big.Rat).Mul(root, root).Cmp(square) == 0 {
+			return FromRat(root, base)
+		}
+	}
+
+	// ⌊√(𝑥·𝑏²ᵖ)⌋ yields the root's first 𝑝 placeholders exactly
+	scale := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(2*atlas.Precision)), nil)
+	scaled := ratFloor(square.Mul(square, new(big.Rat).SetInt(scale)))
+	return irrationalOfScaled(scaled.Sqrt(scaled), false, base, atlas.Precision)
+}
+
+// Angle returns the argument of the complex number in radians, in the half-open interval (-π, π].
+//
+//	arg(𝑎 + 𝑏𝑖) = atan2(𝑏, 𝑎)
+//
+// NOTE: The angle of zero is defined as zero.
+func (z RealizedComplex) Angle() Realized {
+	z.sanityCheck()
+	a, _ := complexRat(z.real)
+	b, _ := complexRat(z.imaginary)
+	base := z.Base()
+
+	if b.Sign() == 0 && a.Sign() >= 0 {
+		return FromRat(new(big.Rat), base)
+	}
+
+	bits := transcendentalBits(base)
+	return realizedOfFloat(atan2(new(big.Float).SetPrec(bits).SetRat(b), new(big.Float).SetPrec(bits).SetRat(a)), base)
+}
+
+// Polar returns the magnitude and angle of the complex number - see RealizedComplex.Magnitude and RealizedComplex.Angle
+func (z RealizedComplex) Polar() (magnitude Realized, angle Realized) {
+	return z.Magnitude(), z.Angle()
+}
+
+/**
+Printing
+*/
+
+// String - see.PrintingNumbers
+//
+//	"~1.4142136 + 2.‾3i"
+//	"1 - 2i"
+func (z RealizedComplex) String() string {
+	z.sanityCheck()
+	return joinComplex(z.real.String(), z.imaginary.String(), z.Base())
+}
+
+// Print - see.PrintingNumbers
+//
+// Both parts are printed to the provided fractional width, and in the provided base - or the stored base if omitted.
+func (z RealizedComplex) Print(fractionalWidth int, base ...uint16) string {
+	z.sanityCheck()
+	b := z.Base()
+	if len(base) > 0 {
+		b = PanicIfInvalidBase(base...)
+	}
+	return joinComplex(z.real.Print(fractionalWidth, b), z.imaginary.Print(fractionalWidth, b), b)
+}
+
+/**
+Internals
+*/
+
+func (z RealizedComplex) sanityCheck() {
+	if z.real == nil || z.imaginary == nil {
+		panic("this complex number was not created through a constructor")
+	}
+}
+
+// complexParts holds the exact rational parts of two operands, 𝑎 + 𝑏𝑖 and 𝑐 + 𝑑𝑖, alongside whether each is irrational.
+type complexParts struct {
+	a, b, c, d     *big.Rat
+	ai, bi, ci, di bool
+}
+
+// parts returns the exact rational parts of both operands, and whether each was irrational.
+func (z RealizedComplex) parts(operand RealizedComplex) complexParts {
+	z.sanityCheck()
+	operand.sanityCheck()
+
+	var p complexParts
+	p.a, p.ai = complexRat(z.real)
+	p.b, p.bi = complexRat(z.imaginary)
+	p.c, p.ci = complexRat(operand.real)
+	p.d, p.di = complexRat(operand.imaginary)
+	return p
+}
+
+// irrationalProduct returns whether the product of the two provided parts is irrational - which it can't be if either
+// of them is an exact zero.
+func irrationalProduct(x *big.Rat, xIrrational bool, y *big.Rat, yIrrational bool) bool {
+	return (xIrrational || yIrrational) && (xIrrational || x.Sign() != 0) && (yIrrational || y.Sign() != 0)
+}
+
+// derive creates a complex number in this number's base from the provided rational parts, each of which is either
+// exact or irrational.
+func (z RealizedComplex) derive(re, im *big.Rat, reIrrational, imIrrational bool) RealizedComplex {
+	base := z.Base()
+	r := realizedOfRat(re, base, reIrrational)
+	i := realizedOfRat(im, base, imIrrational)
+	return RealizedComplex{real: &r, imaginary: &i}
+}
+
+// complexPart returns the provided operand as a realized number.
+func complexPart(operand any, base ...uint16) *Realized {
+	switch typed := operand.(type) {
+	case *Realized:
+		return typed
+	case Realized:
+		return &typed
+	case complex64, complex128:
+		panic("a complex part cannot itself be complex")
+	}
+	r := FromRat(ToRat(operand), base...)
+	return &r
+}
+
+// complexRat returns the realized number's calculated value and whether it's irrational.
+func complexRat(r *Realized) (*big.Rat, bool) {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+	return realizedToRat(r), r.irrational
+}
+
+// joinComplex places the sign of the imaginary part between the two parts - moving it beyond any irrational marker.
+func joinComplex(re, im string, base uint16) string {
+	separator := ""
+	if base > 16 {
+		separator = " "
+	}
+
+	marker, negative := "", false
+	if rest, ok := strings.CutPrefix(im, "~"); ok {
+		marker = "~" + separator
+		im = strings.TrimLeft(rest, " ")
+	}
+	if rest, ok := strings.CutPrefix(im, "-"); ok {
+		negative = true
+		im = strings.TrimLeft(rest, " ")
+	}
+
+	if negative {
+		return re + " - " + marker + im + separator + "i"
+	}
+	return re + " + " + marker + im + separator + "i"
+}
+
+/**
+Realization
+*/
+
+// realizedOfRat creates a realized number from the provided rational, which is either exact or an irrational
+// approximation to atlas.Precision placeholders.
+func realizedOfRat(r *big.Rat, base uint16, irrational bool) Realized {
+	if !irrational {
+		return FromRat(r, base)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(atlas.Precision)), nil)
+	scaled := ratFloor(new(big.Rat).Mul(new(big.Rat).Abs(r), new(big.Rat).SetInt(scale)))
+	return irrationalOfScaled(scaled, r.Sign() < 0, base, atlas.Precision)
+}
+
+// realizedOfFloat creates an irrational realized number from the provided float to atlas.Precision placeholders.
+func realizedOfFloat(f *big.Float, base uint16) Realized {
+	scale := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(atlas.Precision)), nil)
+	scaled := new(big.Float).SetPrec(f.Prec()).Abs(f)
+	scaled.Mul(scaled, new(big.Float).SetPrec(f.Prec()).SetInt(scale))
+	out, _ := scaled.Int(nil)
+	return irrationalOfScaled(out, f.Sign() < 0, base, atlas.Precision)
+}
+
+/**
+Transcendentals
+*/
+
+// transcendentalBits returns the binary precision needed to calculate atlas.Precision placeholders in the provided
+// base, along with enough guard bits to absorb the rounding of each series.
+func transcendentalBits(base uint16) uint {
+	return uint(float64(atlas.Precision)*math.Log2(float64(base))) + 64
+}
+
+// atan calculates the arctangent through repeated half-angle reduction, followed by its Taylor series.
+//
+//	atan(𝑥) = 2·atan(𝑥 / (1 + √(1 + 𝑥²)))
+//	atan(𝑥) = 𝑥 - 𝑥³/3 + 𝑥⁵/5 - …
+func atan(x *big.Float) *big.Float {
+	prec := x.Prec()
+	one := new(big.Float).SetPrec(prec).SetInt64(1)
+
+	const halvings = 8
+	x = new(big.Float).SetPrec(prec).Set(x)
+	for i := 0; i < halvings; i++ {
+		root := new(big.Float).SetPrec(prec).Mul(x, x)
+		root.Add(root, one)
+		root.Sqrt(root)
+		x.Quo(x, root.Add(root, one))
+	}
+
+	out := new(big.Float).SetPrec(prec).Set(x)
+	power := new(big.Float).SetPrec(prec).Set(x)
+	square := new(big.Float).SetPrec(prec).Mul(x, x)
+	term := new(big.Float).SetPrec(prec)
+	for n := int64(1); ; n++ {
+		power.Mul(power, square)
+		term.Quo(power, new(big.Float).SetPrec(prec).SetInt64(2*n+1))
+		if term.Sign() == 0 || term.MantExp(nil)-out.MantExp(nil) < -int(prec) {
+			break
+		}
+		if n%2 == 1 {
+			out.Sub(out, term)
+		} else {
+			out.Add(out, term)
+		}
+	}
+	return out.SetMantExp(out, halvings)
+}
+
+// atan2 calculates the angle of the point (𝑥, 𝑦) in the half-open interval (-π, π].
+func atan2(y, x *big.Float) *big.Float {
+	prec := y.Prec()
+	pi := piOf(prec)
+	if x.Sign() == 0 {
+		pi.SetMantExp(pi, -1)
+		if y.Sign() < 0 {
+			pi.Neg(pi)
+		}
+		return pi
+	}
+
+	out := atan(new(big.Float).SetPrec(prec).Quo(y, x))
+	if x.Sign() < 0 {
+		if y.Sign() < 0 {
+			return out.Sub(out, pi)
+		}
+		return out.Add(out, pi)
+	}
+	return out
+}
+
+// piOf calculates π using Machin's formula.
+//
+//	π = 16·atan(1/5) - 4·atan(1/239)
+func piOf(prec uint) *big.Float {
+	fifth := atan(new(big.Float).SetPrec(prec).Quo(new(big.Float).SetPrec(prec).SetInt64(1), new(big.Float).SetPrec(prec).SetInt64(5)))
+	other := atan(new(big.Float).SetPrec(prec).Quo(new(big.Float).SetPrec(prec).SetInt64(1), new(big.Float).SetPrec(prec).SetInt64(239)))
+	fifth.SetMantExp(fifth, 4)
+	other.SetMantExp(other, 2)
+	return fifth.Sub(fifth, other)
+}
+
+// sinCos calculates the sine and cosine of 𝑥 by reducing it into [-π, π], halving it several times, summing both
+// Taylor series, and then doubling the angle back again.
+//
+//	sin(2𝑥) = 2·sin(𝑥)·cos(𝑥)
+//	cos(2𝑥) = 1 - 2·sin²(𝑥)
+func sinCos(x *big.Float) (sin *big.Float, cos *big.Float) {
+	prec := x.Prec()
+	one := new(big.Float).SetPrec(prec).SetInt64(1)
+
+	tau := piOf(prec)
+	tau.SetMantExp(tau, 1)
+	turns := new(big.Float).SetPrec(prec).Quo(x, tau)
+	rounded, _ := turns.Add(turns, new(big.Float).SetFloat64(0.5)).Int(nil)
+	if turns.Sign() < 0 {
+		rounded.Sub(rounded, big.NewInt(1))
+	}
+	x = new(big.Float).SetPrec(prec).Sub(x, tau.Mul(tau, new(big.Float).SetPrec(prec).SetInt(rounded)))
+
+	const halvings = 16
+	x.SetMantExp(x, -halvings)
+
+	// sin(𝑥) = 𝑥 - 𝑥³/3! + …   and   cos(𝑥) = 1 - 𝑥²/2! + …
+	sin = new(big.Float).SetPrec(prec).Set(x)
+	cos = new(big.Float).SetPrec(prec).Set(one)
+	square := new(big.Float).SetPrec(prec).Mul(x, x)
+	sinTerm := new(big.Float).SetPrec(prec).Set(x)
+	cosTerm := new(big.Float).SetPrec(prec).Set(one)
+	for n := int64(1); ; n++ {
+		sinTerm.Mul(sinTerm, square)
+		sinTerm.Quo(sinTerm, new(big.Float).SetPrec(prec).SetInt64((2*n)*(2*n+1)))
+		cosTerm.Mul(cosTerm, square)
+		cosTerm.Quo(cosTerm, new(big.Float).SetPrec(prec).SetInt64((2*n-1)*(2*n)))
+		if n%2 == 1 {
+			sin.Sub(sin, sinTerm)
+			cos.Sub(cos, cosTerm)
+		} else {
+			sin.Add(sin, sinTerm)
+			cos.Add(cos, cosTerm)
+		}
+		if cosTerm.Sign() == 0 || cosTerm.MantExp(nil) < -int(prec) {
+			break
+		}
+	}
+
+	for i := 0; i < halvings; i++ {
+		double := new(big.Float).SetPrec(prec).Mul(sin, cos)
+		square.Mul(sin, sin)
+		cos.Sub(one, square.SetMantExp(square, 1))
+		sin = double.SetMantExp(double, 1)
+	}
+	return sin, cos
+}
@@ -0,0 +1,209 @@
+package num
+
+import (
+	"core/enum/change"
+	"sync"
+	"sync/atomic"
+)
+
+// Concurrent wraps a Numeric[T] for safe use across goroutines.
+//
+// Integer types are lock-free - every operation is applied to a private copy of the current Numeric, which is then
+// published through an atomic compare-and-swap of the snapshot pointer.  If another goroutine publishes first, the
+// operation simply retries against the newer snapshot.  Because a published snapshot is never mutated, reads never
+// race against the lazy initialization inside a Numeric.
+//
+// NOTE: This is copy-on-write, not hardware atomic arithmetic - every integer operation allocates a new Numeric, and
+// heavy contention will retry (and allocate) repeatedly.  If you only need a raw unbounded counter, sync/atomic is far
+// cheaper - a Concurrent exists to keep the boundaries, overflow policy, and subscribers consistent.
+//
+// Floating point and advanced types are instead guarded by a mutex, as their arithmetic is too costly to retry.
+//
+// Subscribers are only notified of committed changes, after they've been published.
+//
+// NOTE: A Concurrent must not be copied after first use - please create one using NewConcurrent and share the pointer.
+//
+// See NewConcurrent, Concurrent.CompareAndSwap, Concurrent.Increment, Concurrent.AddOrSubtract, and Concurrent.Update
+type Concurrent[T Advanced] struct {
+	lockFree bool
+	snapshot atomic.Pointer[Numeric[T]]
+
+	gate    sync.Mutex
+	numeric Numeric[T]
+}
+
+// NewConcurrent creates a concurrency-safe copy of the provided Numeric.
+//
+// See Concurrent
+func NewConcurrent[T Advanced](n Numeric[T]) *Concurrent[T] {
+	n.sanityCheck()
+	n.observing()
+
+	var zero T
+	c := &Concurrent[T]{lockFree: IsInteger(zero)}
+	if c.lockFree {
+		c.snapshot.Store(&n)
+	} else {
+		c.numeric = n
+	}
+	return c
+}
+
+// Load returns a copy of the current Numeric - which may be freely read or mutated without affecting this value.
+func (c *Concurrent[T]) Load() Numeric[T] {
+	if c.lockFree {
+		return *c.snapshot.Load()
+	}
+
+	c.gate.Lock()
+	defer c.gate.Unlock()
+	return c.numeric
+}
+
+// Value returns the currently held value.
+func (c *Concurrent[T]) Value() T {
+	n := c.Load()
+	return n.Value()
+}
+
+// Minimum returns the current minimum boundary.
+func (c *Concurrent[T]) Minimum() T {
+	n := c.Load()
+	return n.Minimum()
+}
+
+// Maximum returns the current maximum boundary.
+func (c *Concurrent[T]) Maximum() T {
+	n := c.Load()
+	return n.Maximum()
+}
+
+// Set atomically sets the bounded value and returns its Breach - see Numeric.Set.
+func (c *Concurrent[T]) Set(value T) Breach {
+	return c.Update(func(n *Numeric[T]) Breach {
+		return n.Set(value)
+	})
+}
+
+// Increment atomically adds 1 or the provided amount to the bounded value and returns its Breach - see Numeric.Increment.
+func (c *Concurrent[T]) Increment(amount ...T) Breach {
+	return c.Update(func(n *Numeric[T]) Breach {
+		return n.Increment(amount...)
+	})
+}
+
+// Decrement atomically subtracts 1 or the provided amount from the bounded value and returns its Breach - see Numeric.Decrement.
+func (c *Concurrent[T]) Decrement(amount ...T) Breach {
+	return c.Update(func(n *Numeric[T]) Breach {
+		return n.Decrement(amount...)
+	})
+}
+
+// AddOrSubtract atomically adds or subtracts the provided amount and returns its Breach - see Numeric.AddOrSubtract.
+func (c *Concurrent[T]) AddOrSubtract(amount T) Breach {
+	return c.Update(func(n *Numeric[T]) Breach {
+		return n.AddOrSubtract(amount)
+	})
+}
+
+// SetBoundaries atomically sets the boundaries and re-bounds the current value against them - see Numeric.SetBoundaries.
+func (c *Concurrent[T]) SetBoundaries(minimum, maximum T) Breach {
+	return c.Update(func(n *Numeric[T]) Breach {
+		return n.SetBoundaries(minimum, maximum)
+	})
+}
+
+// CompareAndSwap sets the value to next only if it currently holds old, returning whether the swap occurred and
+// the Breach of setting next.
+//
+// NOTE: The comparison is made against the held value - so if next breaches the boundaries, a later swap must
+// compare against its bounded result rather than next itself.
+func (c *Concurrent[T]) CompareAndSwap(old, next T) (bool, Breach) {
+	var swapped bool
+	breach := c.update(func(n *Numeric[T]) (Breach, bool) {
+		// NOTE: This may be retried, so the outcome is decided by the final attempt alone
+		swapped = Compare(n.Value(), old) == 0
+		if !swapped {
+			return "", false
+		}
+		return n.Set(next), true
+	})
+	return swapped, breach
+}
+
+// Update atomically applies the provided operation to the held Numeric and returns its Breach.  This allows
+// compound changes - such as altering the Overflow policy and value together - to be made as a single step.
+//
+// NOTE: For integer types, the operation may be called several times while contending with other goroutines - so
+// it should only act upon the provided Numeric.
+func (c *Concurrent[T]) Update(operation func(n *Numeric[T]) Breach) Breach {
+	return c.update(func(n *Numeric[T]) (Breach, bool) {
+		return operation(n), true
+	})
+}
+
+// update applies the operation to a copy of the current state and publishes it if the operation commits.
+//
+// NOTE: The copy is detached from any subscribers while the operation runs, so retried attempts stay silent - only
+// the committed difference is announced, after the state has been published.
+func (c *Concurrent[T]) update(operation func(n *Numeric[T]) (Breach, bool)) Breach {
+	if !c.lockFree {
+		previous, next, breach, commit := c.locked(operation)
+		if commit && next.observers != nil {
+			next.observers.announce(&previous, &next, breach)
+		}
+		return breach
+	}
+
+	for {
+		current := c.snapshot.Load()
+		next := *current
+		next.observers = nil
+		breach, commit := operation(&next)
+		next.observers = current.observers
+		if !commit {
+			return breach
+		}
+		if c.snapshot.CompareAndSwap(current, &next) {
+			if next.observers != nil {
+				next.observers.announce(current, &next, breach)
+			}
+			return breach
+		}
+	}
+}
+
+// locked applies the operation to a copy of the mutex guarded state, returning both the previous and next states.
+func (c *Concurrent[T]) locked(operation func(n *Numeric[T]) (Breach, bool)) (Numeric[T], Numeric[T], Breach, bool) {
+	c.gate.Lock()
+	defer c.gate.Unlock()
+
+	previous := c.numeric
+	next := c.numeric
+	next.observers = nil
+	breach, commit := operation(&next)
+	next.observers = previous.observers
+	if commit {
+		c.numeric = next
+	}
+	return previous, next, breach, commit
+}
+
+// Subscribe registers the provided action to be called with every committed event of the provided kinds, or every
+// event if none are provided - see Numeric.Subscribe.
+func (c *Concurrent[T]) Subscribe(action func(Event[T]), kinds ...change.Kind) *Subscription {
+	n := c.Load()
+	return n.Subscribe(action, kinds...)
+}
+
+// Events returns a channel which receives every committed event of the provided kinds - see Numeric.Events.
+func (c *Concurrent[T]) Events(buffer int, kinds ...change.Kind) (<-chan Event[T], *Subscription) {
+	n := c.Load()
+	return n.Events(buffer, kinds...)
+}
+
+// String returns the current value as a numeric string.
+func (c *Concurrent[T]) String() string {
+	n := c.Load()
+	return n.String()
+}
@@ -0,0 +1,185 @@
+package num
+
+import (
+	"core/sys/atlas"
+	"math/big"
+)
+
+// ContinuedFraction returns up to the provided number of coefficients of the realized number's simple continued
+// fraction, stopping early if the expansion terminates.
+//
+//	𝑥 = 𝑎₀ + 1/(𝑎₁ + 1/(𝑎₂ + …))  →  [𝑎₀; 𝑎₁, 𝑎₂, …]
+//
+//	3.245  →  [3; 4, 12, 4]
+//	√2     →  [1; 2, 2, 2, 2 …]
+//
+// NOTE: Naturals cannot hold a sign, so this expands the absolute value - please check Realized.Negative.
+//
+// NOTE: Irrational values can only be expanded from their currently calculated precision, so the trailing
+// coefficients of a long expansion describe the approximation rather than the number itself.
+//
+// See Realized.ContinuedFraction, Realized.BestRational, FromContinuedFraction, and FromPeriodicContinuedFraction
+func (r *Realized) ContinuedFraction(terms uint) []Natural {
+	r.sanityCheck()
+
+	x := ToRat(r)
+	x.Abs(x)
+
+	numerator := new(big.Int).Set(x.Num())
+	denominator := new(big.Int).Set(x.Denom())
+	remainder := new(big.Int)
+
+	out := make([]Natural, 0, terms)
+	for uint(len(out)) < terms && denominator.Sign() != 0 {
+		a, _ := new(big.Int).QuoRem(numerator, denominator, remainder)
+		out = append(out, intToNatural(a))
+		numerator.Set(denominator)
+		denominator.Set(remainder)
+	}
+	return out
+}
+
+// BestRational returns the closest rational to the realized number whose denominator does not exceed the provided
+// maximum.  Every convergent of the continued fraction is considered, as well as the semiconvergents which lie
+// between the final two - so the result is always the best possible approximation.
+//
+//	π.BestRational(10)     // 22/7
+//	π.BestRational(1000)   // 355/113
+//
+// NOTE: This will panic if the maximum denominator is zero.
+//
+// See Realized.ContinuedFraction, Realized.BestRational, FromContinuedFraction, and FromPeriodicContinuedFraction
+func (r *Realized) BestRational(maxDenominator Natural) *big.Rat {
+	r.sanityCheck()
+	maxDenominator.nonZero("limit a denominator to")
+	limit := maxDenominator.toInt()
+
+	x := ToRat(r)
+	if x.Denom().Cmp(limit) <= 0 {
+		return x
+	}
+	negative := x.Sign() < 0
+	x.Abs(x)
+
+	// The convergents pₙ/qₙ are accumulated until the next would exceed the limit
+	p0, q0, p1, q1 := big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(0)
+	numerator := new(big.Int).Set(x.Num())
+	denominator := new(big.Int).Set(x.Denom())
+	remainder := new(big.Int)
+	for denominator.Sign() != 0 {
+		a, _ := new(big.Int).QuoRem(numerator, denominator, remainder)
+		q2 := new(big.Int).Add(q0, new(big.Int).Mul(a, q1))
+		if q2.Cmp(limit) > 0 {
+			break
+		}
+		p2 := new(big.Int).Add(p0, new(big.Int).Mul(a, p1))
+		p0, q0, p1, q1 = p1, q1, p2, q2
+		numerator.Set(denominator)
+		denominator.Set(remainder)
+	}
+
+	// The largest semiconvergent within the limit - (pₙ₋₁ + 𝑘·pₙ) / (qₙ₋₁ + 𝑘·qₙ)
+	k := new(big.Int).Quo(new(big.Int).Sub(limit, q0), q1)
+	semiconvergent := new(big.Rat).SetFrac(
+		new(big.Int).Add(p0, new(big.Int).Mul(k, p1)),
+		new(big.Int).Add(q0, new(big.Int).Mul(k, q1)),
+	)
+	convergent := new(big.Rat).SetFrac(p1, q1)
+
+	out := convergent
+	semiDistance := new(big.Rat).Sub(semiconvergent, x)
+	convDistance := new(big.Rat).Sub(convergent, x)
+	if semiDistance.Abs(semiDistance).Cmp(convDistance.Abs(convDistance)) < 0 {
+		out = semiconvergent
+	}
+	if negative {
+		out.Neg(out)
+	}
+	return out
+}
+
+// FromContinuedFraction creates a static realized number from the coefficients of a finite simple continued fraction
+// in the provided base, or base₁₀ if omitted.
+//
+//	FromContinuedFraction([3; 4, 12, 4])  // 3.245
+//
+// NOTE: This will panic if no coefficients are provided, or if any coefficient after the first is zero.
+//
+// See Realized.ContinuedFraction, Realized.BestRational, FromContinuedFraction, and FromPeriodicContinuedFraction
+func FromContinuedFraction(terms []Natural, base ...uint16) Realized {
+	if len(terms) == 0 {
+		panic("a continued fraction requires at least one coefficient")
+	}
+	coefficientsCheck(terms[1:])
+
+	out := new(big.Rat).SetInt(terms[len(terms)-1].toInt())
+	for i := len(terms) - 2; i >= 0; i-- {
+		out.Inv(out)
+		out.Add(out, new(big.Rat).SetInt(terms[i].toInt()))
+	}
+	return FromRat(out, base...)
+}
+
+// FromPeriodicContinuedFraction creates a static realized number from a continued fraction whose period repeats
+// forever - which describes exactly the quadratic irrationals.
+//
+//	√2 = [1; 2, 2, 2 …]         →  FromPeriodicContinuedFraction([1], [2])
+//	φ  = [1; 1, 1, 1 …]         →  FromPeriodicContinuedFraction(nil, [1])
+//	√7 = [2; 1, 1, 1, 4, 1 …]   →  FromPeriodicContinuedFraction([2], [1, 1, 1, 4])
+//
+// The convergents of a continued fraction alternate either side of its value - so the digits are revealed by
+// expanding convergents until two consecutive ones agree to atlas.Precision placeholders, which is then the value's
+// irrational approximation.
+//
+// NOTE: If no period is provided, this is identical to FromContinuedFraction.
+//
+// NOTE: This will panic if any coefficient other than the first in the prefix is zero.
+//
+// See Realized.ContinuedFraction, Realized.BestRational, FromContinuedFraction, and FromPeriodicContinuedFraction
+func FromPeriodicContinuedFraction(prefix []Natural, period []Natural, base ...uint16) Realized {
+	if len(period) == 0 {
+		return FromContinuedFraction(prefix, base...)
+	}
+	if len(prefix) > 0 {
+		coefficientsCheck(prefix[1:])
+	}
+	coefficientsCheck(period)
+
+	b := PanicIfInvalidBase(base...)
+	precision := atlas.Precision
+	scale := new(big.Int).Exp(big.NewInt(int64(b)), big.NewInt(int64(precision)), nil)
+
+	term := func(i int) *big.Int {
+		if i < len(prefix) {
+			return prefix[i].toInt()
+		}
+		return period[(i-len(prefix))%len(period)].toInt()
+	}
+
+	// hₙ = 𝑎ₙ·hₙ₋₁ + hₙ₋₂   and   𝑘ₙ = 𝑎ₙ·𝑘ₙ₋₁ + 𝑘ₙ₋₂
+	h0, k0, h1, k1 := big.NewInt(0), big.NewInt(1), big.NewInt(1), big.NewInt(0)
+	var previous *big.Int
+	for i := 0; ; i++ {
+		a := term(i)
+		h2 := new(big.Int).Add(h0, new(big.Int).Mul(a, h1))
+		k2 := new(big.Int).Add(k0, new(big.Int).Mul(a, k1))
+		h0, k0, h1, k1 = h1, k1, h2, k2
+
+		scaled := new(big.Int).Quo(new(big.Int).Mul(h1, scale), k1)
+		if previous != nil && scaled.Cmp(previous) == 0 {
+			break
+		}
+		previous = scaled
+	}
+
+	return irrationalOfScaled(previous, false, b, precision)
+}
+
+// coefficientsCheck panics if any of the provided continued fraction coefficients are zero.
+func coefficientsCheck(terms []Natural) {
+	for _, t := range terms {
+		if t.toInt().Sign() == 0 {
+			panic("only the first coefficient of a continued fraction may be zero")
+		}
+	}
+}
@@ -14,7 +14,8 @@ the 𝑡𝑖𝑛𝑦 types - which can be explored through tiny.Realized
 There are many convenience methods in this package:
 
 Random number generation -
-Random, RandomWithinRange, RandomSet, RandomSetWithinRange
+Random, RandomWithinRange, RandomSet, RandomSetWithinRange, RandomNatural, RandomNaturalBelow, RandomNaturalWithinRange,
+RandomNaturalSetWithinRange, and RandomRealizedWithinRange
 
 Anonymous procedures -
 ToString, TypeAssert, Smallest, Largest, Compare, IsNumeric, IsPrimitive, IsSigned, IsFloat, IsComplex, IsInteger, IsInf, and IsNaN
@@ -22,6 +23,30 @@ ToString, TypeAssert, Smallest, Largest, Compare, IsNumeric, IsPrimitive, IsSign
 Minimum/Maximums -
 MinValue[Primitive], MaxValue[Primitive]
 
+Rationals and floating point formats -
+ToRat, FromRat, FromContinuedFraction, FromPeriodicContinuedFraction, and FloatFormat (Binary16, BFloat16, Binary32, Binary64, Binary128, and Minifloat)
+
+Arbitrary precision complex numbers -
+RealizedComplex, ParseComplex, ComplexOf, and FromPolar
+
+Interval arithmetic with guaranteed error bounds -
+Interval, NewInterval, IntervalOf, and Refine
+
+Immutable values of a live realized number -
+Realized.Snapshot and Snapshot
+
+Regional number formatting and parsing -
+NumberFormat (FormatPlain, FormatWestern, FormatContinental, FormatIndian, and FormatSI), Natural.PrintFormatted,
+Realized.PrintFormatted, NumberFormat.Align, NumberFormat.Parse, and NumberFormat.ParseNatural
+
+Column alignment -
+ToStringAligned, ToStringAlignedWith, and AlignOptions
+
+Property-based testing - see the numtest package for operand generators and invariant checks
+
+Number theory on Natural -
+DivMod, Mod, GCD, ExtendedGCD (and Signed), LCM, ModPow, ModInverse, Jacobi, ISqrt, IsPrime, and Factor
+
 ---------
 Transcendentals
 
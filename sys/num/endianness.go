@@ -0,0 +1,105 @@
+package num
+
+import (
+	"core/enum/endian"
+	"core/sys/support"
+	"fmt"
+	"slices"
+)
+
+// NewMeasurementOf creates a new memory-faithful Measurement of the provided value - meaning the bytes are captured
+// exactly as they are laid out in operating memory, and the executing hardware's endian.Endianness is recorded
+// alongside them.  If you provide an endianness, the measured bytes are then reordered into that form.
+//
+// This works for every primitive type, as well as strings, slices of primitives, and any fixed-size struct.
+//
+// For example, on a little endian machine -
+//
+//	NewMeasurementOf[uint16](0x4D2C)                        // | 0 0 1 0 1 1 0 0 | 0 1 0 0 1 1 0 1 | (LittleEndian)
+//	NewMeasurementOf[uint16](0x4D2C, endian.Big)            // | 0 1 0 0 1 1 0 1 | 0 0 1 0 1 1 0 0 | (BigEndian)
+//	NewMeasurementOf[uint16](0x4D2C, endian.BitwiseLittle)  // | 0 0 1 1 0 1 0 0 | 1 0 1 1 0 0 1 0 | (BitwiseLittleEndian)
+//
+// NOTE: Be sure to explicitly provide the type parameter to ensure Go doesn't implicitly
+// give you, say, all 8 bytes worth of an 'int' to represent a single 'byte' =)
+//
+// NOTE: Structs are reordered as a single contiguous word - the endianness of their individual fields is not inferred.
+//
+// See NewMeasurementOf, Measurement.ToEndian, and DecodeMeasurement
+func NewMeasurementOf[T any](value T, endianness ...endian.Endianness) Measurement {
+	m := Measurement{
+		Endianness: support.GetArchitectureEndianness(),
+		Bytes:      support.Measure[T](value)[0],
+		Bits:       []Bit{},
+	}
+	if len(endianness) > 0 {
+		m = m.ToEndian(endianness[0])
+	}
+	return m
+}
+
+// ToEndian reorders the measured bytes from their currently recorded endian.Endianness into the provided form, and then
+// records the new endianness on the output Measurement.  Because the layout is always tracked, you may freely walk a
+// measurement between forms and back to its original order again.
+//
+// NOTE: Reordering bytes requires a byte-aligned measurement - this will panic if there are any remaining bits.
+//
+// See NewMeasurementOf, Measurement.ToEndian, and DecodeMeasurement
+func (a Measurement) ToEndian(e endian.Endianness) Measurement {
+	a = a.sanityCheck()
+
+	if a.Endianness == e {
+		return a
+	}
+	if len(a.Bits) > 0 {
+		panic(fmt.Sprintf("cannot reorder a %d-bit measurement that isn't byte-aligned", a.BitWidth()))
+	}
+
+	bytes := slices.Clone(a.Bytes)
+
+	// Walk the current form back to standard endian.Big...
+	switch a.Endianness {
+	case endian.Big:
+	case endian.Little:
+		slices.Reverse(bytes)
+	case endian.BitwiseLittle:
+		reverseBitwise(bytes)
+	default:
+		panic(fmt.Sprintf("unknown endianness '%v'", a.Endianness))
+	}
+
+	// ...and then out to the requested form
+	switch e {
+	case endian.Big:
+	case endian.Little:
+		slices.Reverse(bytes)
+	case endian.BitwiseLittle:
+		reverseBitwise(bytes)
+	default:
+		panic(fmt.Sprintf("unknown endianness '%v'", e))
+	}
+
+	a.Bytes = bytes
+	a.Endianness = e
+	return a
+}
+
+// DecodeMeasurement reconstructs a typed value from a Measurement by walking its bytes back into the executing
+// hardware's endian.Endianness and then reading them as the provided type.  This is the inverse of NewMeasurementOf.
+//
+// NOTE: Go does not allow type parameters on methods, so this cannot be called as 'Measurement.Decode[T]()'
+//
+// NOTE: The measurement must be byte-aligned and exactly as wide as the type - otherwise, this will panic.
+//
+// See NewMeasurementOf, Measurement.ToEndian, and DecodeMeasurement
+func DecodeMeasurement[T any](m Measurement) T {
+	m = m.ToEndian(support.GetArchitectureEndianness())
+	return support.Unmeasure[T](m.Bytes)
+}
+
+// reverseBitwise reverses the order of all bits in the provided bytes, in place.
+func reverseBitwise(bytes []byte) {
+	slices.Reverse(bytes)
+	for i, b := range bytes {
+		bytes[i] = support.ReverseByte(b)
+	}
+}
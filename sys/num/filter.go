@@ -17,6 +17,7 @@ import (
 //	2 - float32, float64 - Panics on Inf or NaN, then calls num.ToString
 //	3 - big.Int, big.Float - Calls big.Text
 //	4 - num.Realized, num.Realization, num.Measurement - Passes through
+//	4 - num.Snapshot - Converts to a static num.Realized
 //	5 - string - Passes through
 //	6 - []byte - Converts to a Natural as 'digits'
 //
@@ -59,6 +60,8 @@ func FilterOperands(base uint16, operands ...any) []any {
 			return ToString(raw)
 		case Realized, Natural, Measurement, Realization:
 			return raw
+		case Snapshot:
+			return raw.Realized()
 		case []byte:
 			digits := make([]string, len(raw))
 			for i, d := range raw {
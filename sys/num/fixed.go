@@ -0,0 +1,272 @@
+package num
+
+import (
+	"core/enum/endian"
+	"fmt"
+	"math/big"
+)
+
+// FixedFormat describes a signed two's complement Qm.n fixed-point layout, using the ARM convention - where
+// the m integer bits include the sign bit, and the n fractional bits scale the raw integer by 2⁻ⁿ.
+//
+//	| ± integer | fraction |
+//	|     1     |    15    |  ← Q15 (Q1.15), in the closed interval [-1, 1 - 2⁻¹⁵]
+//
+// NOTE: The total width must be in the closed interval [2, 32] - keeping every intermediate product within an int64.
+//
+// See Q15, Q31, Q8_8, Q16_16, Q, and Fixed
+type FixedFormat struct {
+	Integer  uint
+	Fraction uint
+}
+
+var (
+	// Q15 is the Q1.15 format - the workhorse of 16-bit digital signal processing.
+	Q15 = FixedFormat{Integer: 1, Fraction: 15}
+
+	// Q31 is the Q1.31 format.
+	Q31 = FixedFormat{Integer: 1, Fraction: 31}
+
+	// Q8_8 is the Q8.8 format.
+	Q8_8 = FixedFormat{Integer: 8, Fraction: 8}
+
+	// Q16_16 is the Q16.16 format.
+	Q16_16 = FixedFormat{Integer: 16, Fraction: 16}
+)
+
+// Q creates a Qm.n fixed-point format of the provided integer (including sign) and fractional bit widths.
+func Q(integer, fraction uint) FixedFormat {
+	f := FixedFormat{Integer: integer, Fraction: fraction}
+	f.sanityCheck()
+	return f
+}
+
+// Width returns the total bit width of the format.
+func (f FixedFormat) Width() uint {
+	return f.Integer + f.Fraction
+}
+
+// String returns the format in Qm.n notation.
+func (f FixedFormat) String() string {
+	return fmt.Sprintf("Q%d.%d", f.Integer, f.Fraction)
+}
+
+// New creates a fixed-point number of the provided operand, rounded to the nearest representable value (with ties
+// resolving to even).  If the value exceeds the format's range, it will wrap around in two's complement - unless
+// saturate is true, in which case it's clamped to the nearest boundary.
+//
+// NOTE: The returned Breach is expressed in raw units of 2⁻ⁿ.
+//
+// See FixedFormat.New, FixedFormat.FromRaw, and FixedFormat.Decode
+func (f FixedFormat) New(operand any, saturate ...bool) (Fixed, Breach) {
+	f.sanityCheck()
+
+	scaled := ToRat(operand)
+	scaled.Mul(scaled, pow2(int(f.Fraction)))
+	raw := roundHalfEvenSigned(scaled)
+	if !raw.IsInt64() {
+		panic(fmt.Sprintf("%v exceeds the addressable range of a %v fixed-point number", ToString(operand), f))
+	}
+	return f.FromRaw(raw.Int64(), saturate...)
+}
+
+// FromRaw creates a fixed-point number directly from its raw two's complement integer, which is scaled by 2⁻ⁿ.
+//
+// See FixedFormat.New, FixedFormat.FromRaw, and FixedFormat.Decode
+func (f FixedFormat) FromRaw(raw int64, saturate ...bool) (Fixed, Breach) {
+	f.sanityCheck()
+
+	minimum, maximum := f.bounds()
+	n, breach := NewNumericBounded[int64](raw, minimum, maximum, len(saturate) > 0 && saturate[0])
+	return Fixed{format: f, raw: n}, breach
+}
+
+// Decode interprets the provided two's complement measurement as a fixed-point number of this format.
+//
+// NOTE: Non big endian measurements are first reordered into endian.Big.
+//
+// See FixedFormat.New, FixedFormat.FromRaw, and FixedFormat.Decode
+func (f FixedFormat) Decode(m Measurement, saturate ...bool) Fixed {
+	f.sanityCheck()
+
+	if m.Endianness != endian.Big {
+		m = m.ToEndian(endian.Big)
+	}
+	if m.BitWidth() != f.Width() {
+		panic(fmt.Sprintf("cannot decode a %d-bit measurement as a %d-bit %v", m.BitWidth(), f.Width(), f))
+	}
+
+	bits := m.GetAllBits()
+	raw := -int64(bits[0])
+	for _, b := range bits[1:] {
+		raw = raw<<1 | int64(b)
+	}
+	out, _ := f.FromRaw(raw, saturate...)
+	return out
+}
+
+func (f FixedFormat) sanityCheck() {
+	if f.Integer == 0 {
+		panic("a fixed-point format requires at least one integer bit to hold its sign")
+	}
+	if f.Width() < 2 || f.Width() > 32 {
+		panic(fmt.Sprintf("invalid fixed-point width: %d - must be in [2, 32]", f.Width()))
+	}
+}
+
+func (f FixedFormat) bounds() (int64, int64) {
+	return -1 << (f.Width() - 1), 1<<(f.Width()-1) - 1
+}
+
+// Fixed is a signed Qm.n fixed-point number, held as a bounded raw integer scaled by 2⁻ⁿ.
+//
+// Arithmetic either wraps in two's complement or saturates at the format's boundaries, according to how
+// the number was created - the Breach of every operation reports how far the raw result overflowed.
+//
+// See FixedFormat, Fixed.Add, Fixed.Sub, Fixed.Mul, Fixed.Div, Fixed.Realized, and Fixed.Measurement
+type Fixed struct {
+	format FixedFormat
+	raw    Numeric[int64]
+}
+
+// Format returns the fixed-point format of this number.
+func (a Fixed) Format() FixedFormat {
+	return a.format
+}
+
+// Raw returns the underlying two's complement integer, which is scaled by 2⁻ⁿ.
+func (a Fixed) Raw() int64 {
+	return a.raw.Value()
+}
+
+// Saturating returns true if arithmetic on this number clamps to its boundaries rather than wrapping.
+func (a Fixed) Saturating() bool {
+	return a.raw.Clamp
+}
+
+// Saturate returns a copy of this number which saturates (or wraps, if false) during arithmetic.
+func (a Fixed) Saturate(saturate bool) Fixed {
+	a.raw.Clamp = saturate
+	return a
+}
+
+/**
+Arithmetic
+*/
+
+// Add returns the sum of both operands, which must share the same format.
+func (a Fixed) Add(b Fixed) (Fixed, Breach) {
+	a.sanityCheck(b)
+	return a.with(a.Raw() + b.Raw())
+}
+
+// Sub returns the difference of both operands, which must share the same format.
+func (a Fixed) Sub(b Fixed) (Fixed, Breach) {
+	a.sanityCheck(b)
+	return a.with(a.Raw() - b.Raw())
+}
+
+// Neg returns the negation of this number.
+//
+// NOTE: The negation of the minimum value overflows - wrapping back to itself, or saturating at the maximum.
+func (a Fixed) Neg() (Fixed, Breach) {
+	return a.with(-a.Raw())
+}
+
+// Mul returns the product of both operands, which must share the same format.  The product is rounded to the
+// nearest representable value, with ties resolving to even.
+func (a Fixed) Mul(b Fixed) (Fixed, Breach) {
+	a.sanityCheck(b)
+
+	product := a.Raw() * b.Raw()
+	n := a.format.Fraction
+	if n == 0 {
+		return a.with(product)
+	}
+
+	quotient := product >> n
+	remainder := product - quotient<<n
+	half := int64(1) << (n - 1)
+	if remainder > half || (remainder == half && quotient&1 == 1) {
+		quotient++
+	}
+	return a.with(quotient)
+}
+
+// Div returns the quotient of both operands, which must share the same format.  The quotient is rounded to the
+// nearest representable value, with ties resolving to even.
+//
+// NOTE: This will panic if dividing by zero.
+func (a Fixed) Div(b Fixed) (Fixed, Breach) {
+	a.sanityCheck(b)
+
+	if b.Raw() == 0 {
+		panic("cannot divide a fixed-point number by zero")
+	}
+	quotient := new(big.Rat).SetFrac(big.NewInt(a.Raw()<<a.format.Fraction), big.NewInt(b.Raw()))
+	return a.with(roundHalfEvenSigned(quotient).Int64())
+}
+
+/**
+Conversion
+*/
+
+// Rat returns the exact rational value of this number.
+func (a Fixed) Rat() *big.Rat {
+	out := new(big.Rat).SetInt64(a.Raw())
+	return out.Mul(out, pow2(-int(a.format.Fraction)))
+}
+
+// Realized returns the exact value of this number as a realized number in the provided base, or base₁₀ if omitted.
+//
+// NOTE: Every fixed-point value terminates in base₁₀, so the full expansion is always revealed.
+func (a Fixed) Realized(base ...uint16) Realized {
+	return FromRat(a.Rat(), base...)
+}
+
+// Float64 returns the nearest float64 to this number's value.
+func (a Fixed) Float64() float64 {
+	f, _ := a.Rat().Float64()
+	return f
+}
+
+// Measurement packs this number's raw two's complement integer into a big endian measurement of the format's width.
+func (a Fixed) Measurement() Measurement {
+	raw := uint64(a.Raw())
+	width := a.format.Width()
+
+	bits := make([]Bit, width)
+	for i := uint(0); i < width; i++ {
+		bits[width-1-i] = Bit((raw >> i) & 1)
+	}
+	return NewMeasurement(bits...)
+}
+
+// String returns the exact base₁₀ value of this number.
+func (a Fixed) String() string {
+	r := a.Realized()
+	return r.String()
+}
+
+func (a Fixed) sanityCheck(b Fixed) {
+	if a.format != b.format {
+		panic(fmt.Sprintf("cannot operate on mismatched fixed-point formats %v and %v", a.format, b.format))
+	}
+}
+
+// with sets the provided raw result against this number's boundaries.
+func (a Fixed) with(raw int64) (Fixed, Breach) {
+	n := a.raw
+	breach := n.Set(raw)
+	a.raw = n
+	return a, breach
+}
+
+// roundHalfEvenSigned rounds the provided rational to the nearest integer, resolving ties to even.
+func roundHalfEvenSigned(r *big.Rat) *big.Int {
+	if r.Sign() < 0 {
+		out := roundHalfEven(new(big.Rat).Neg(r))
+		return out.Neg(out)
+	}
+	return roundHalfEven(r)
+}
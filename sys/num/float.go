@@ -0,0 +1,279 @@
+package num
+
+import (
+	"core/enum/endian"
+	"fmt"
+	"math/big"
+)
+
+// FloatFormat describes a binary floating point layout in the style of IEEE 754 - a sign bit, followed by a biased
+// exponent, followed by the stored mantissa (which excludes the implicit leading bit of normal values).
+//
+//	| ± | exponent | mantissa |
+//	| 1 |    5     |    10    |  ← Binary16
+//
+// Measurements decoded through a format yield an exact Realized value, revealing the true expansion of what the
+// bits hold - for instance, Binary16 stores 0.1 as exactly 0.0999755859375.
+//
+// See Binary16, BFloat16, Binary32, Binary64, Binary128, and Minifloat
+type FloatFormat struct {
+	Name     string
+	Exponent uint
+	Mantissa uint
+}
+
+var (
+	// Binary16 is the IEEE 754 half precision format.
+	Binary16 = FloatFormat{Name: "binary16", Exponent: 5, Mantissa: 10}
+
+	// BFloat16 is the 'brain' floating point format - a truncated Binary32 with the same exponent range.
+	BFloat16 = FloatFormat{Name: "bfloat16", Exponent: 8, Mantissa: 7}
+
+	// Binary32 is the IEEE 754 single precision format, equivalent to a float32.
+	Binary32 = FloatFormat{Name: "binary32", Exponent: 8, Mantissa: 23}
+
+	// Binary64 is the IEEE 754 double precision format, equivalent to a float64.
+	Binary64 = FloatFormat{Name: "binary64", Exponent: 11, Mantissa: 52}
+
+	// Binary128 is the IEEE 754 quadruple precision format.
+	Binary128 = FloatFormat{Name: "binary128", Exponent: 15, Mantissa: 112}
+)
+
+// Minifloat creates an IEEE 754 style format of the provided exponent and mantissa widths.  For example, an 8-bit
+// "E5M2" float is Minifloat(5, 2).
+//
+// NOTE: The exponent must be at least 2 bits wide.
+func Minifloat(exponent, mantissa uint) FloatFormat {
+	f := FloatFormat{Name: fmt.Sprintf("E%dM%d", exponent, mantissa), Exponent: exponent, Mantissa: mantissa}
+	f.sanityCheck()
+	return f
+}
+
+// Width returns the total bit width of the format.
+func (f FloatFormat) Width() uint {
+	return 1 + f.Exponent + f.Mantissa
+}
+
+// Bias returns the value subtracted from the stored exponent field of normal values.
+func (f FloatFormat) Bias() int {
+	return 1<<(f.Exponent-1) - 1
+}
+
+// String returns the format's name.
+func (f FloatFormat) String() string {
+	return f.Name
+}
+
+/**
+Decoding
+*/
+
+// Decode interprets the provided measurement in this format and returns its exact value as a realized number.
+//
+// NOTE: Non big endian measurements are first reordered into endian.Big - so a NewMeasurementOf a float32 may be
+// directly decoded by Binary32, regardless of the executing architecture.
+//
+// NOTE: Negative zero is decoded as zero, and this will panic if the measurement holds NaN or Inf - please check
+// using FloatFormat.IsNaN and FloatFormat.IsInf first.
+//
+// See FloatFormat.Decode, FloatFormat.DecodeRat, and FloatFormat.Encode
+func (f FloatFormat) Decode(m Measurement, base ...uint16) Realized {
+	return FromRat(f.DecodeRat(m), base...)
+}
+
+// DecodeRat interprets the provided measurement in this format and returns its exact rational value.
+//
+// See FloatFormat.Decode, FloatFormat.DecodeRat, and FloatFormat.Encode
+func (f FloatFormat) DecodeRat(m Measurement) *big.Rat {
+	negative, exponent, mantissa := f.fields(m)
+
+	if exponent == f.maxExponent() {
+		if mantissa.Sign() == 0 {
+			panic(fmt.Sprintf("cannot decode an Inf valued %v", f))
+		}
+		panic(fmt.Sprintf("cannot decode a NaN valued %v", f))
+	}
+
+	// Normal values have an implicit leading 1 bit, while subnormals share the minimum exponent
+	significand := new(big.Int).Set(mantissa)
+	power := 1 - f.Bias() - int(f.Mantissa)
+	if exponent > 0 {
+		significand.SetBit(significand, int(f.Mantissa), 1)
+		power = exponent - f.Bias() - int(f.Mantissa)
+	}
+
+	out := new(big.Rat).SetInt(significand)
+	scale := new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(abs(power))))
+	if power < 0 {
+		out.Quo(out, scale)
+	} else {
+		out.Mul(out, scale)
+	}
+
+	if negative {
+		out.Neg(out)
+	}
+	return out
+}
+
+// IsNaN returns true if the provided measurement holds a NaN value in this format.
+func (f FloatFormat) IsNaN(m Measurement) bool {
+	_, exponent, mantissa := f.fields(m)
+	return exponent == f.maxExponent() && mantissa.Sign() != 0
+}
+
+// IsInf returns whether the provided measurement holds an infinite value in this format, and whether it's negative.
+func (f FloatFormat) IsInf(m Measurement) (bool, bool) {
+	negative, exponent, mantissa := f.fields(m)
+	return exponent == f.maxExponent() && mantissa.Sign() == 0, negative
+}
+
+/**
+Encoding
+*/
+
+// Encode converts the provided operand into this format, rounding to the nearest representable value (with ties
+// resolving to an even mantissa).  Values beyond the format's range become Inf, while values below its smallest
+// subnormal become zero.
+//
+// NOTE: The operand is first converted using ToRat, so "0.1" and float64(0.1) may round differently - the string
+// is exactly one tenth, while the float64 is already the nearest binary64 value.
+//
+// See FloatFormat.Decode, FloatFormat.DecodeRat, and FloatFormat.Encode
+func (f FloatFormat) Encode(operand any) Measurement {
+	f.sanityCheck()
+
+	value := ToRat(operand)
+	negative := value.Sign() < 0
+	value.Abs(value)
+
+	if value.Sign() == 0 {
+		return f.compose(negative, 0, new(big.Int))
+	}
+
+	// Find e such that 2ᵉ ≤ value < 2ᵉ⁺¹
+	e := value.Num().BitLen() - value.Denom().BitLen()
+	if value.Cmp(pow2(e)) < 0 {
+		e--
+	}
+
+	minimum := 1 - f.Bias()
+	if e < minimum {
+		// Subnormal - the significand is scaled against the minimum exponent, and may round up into the smallest normal
+		significand := roundHalfEven(new(big.Rat).Mul(value, pow2(int(f.Mantissa)-minimum)))
+		return f.compose(negative, 0, significand)
+	}
+
+	significand := roundHalfEven(new(big.Rat).Mul(value, pow2(int(f.Mantissa)-e)))
+	if significand.BitLen() > int(f.Mantissa)+1 {
+		significand.Rsh(significand, 1)
+		e++
+	}
+	if e > f.Bias() {
+		return f.Inf(negative)
+	}
+
+	significand.SetBit(significand, int(f.Mantissa), 0)
+	field := new(big.Int).Lsh(big.NewInt(int64(e+f.Bias())), f.Mantissa)
+	return f.compose(negative, 0, field.Or(field, significand))
+}
+
+// Inf returns the infinite value of this format, which is negative if requested.
+func (f FloatFormat) Inf(negative bool) Measurement {
+	return f.compose(negative, f.maxExponent(), new(big.Int))
+}
+
+// NaN returns the canonical quiet NaN of this format.
+func (f FloatFormat) NaN() Measurement {
+	quiet := new(big.Int).Lsh(big.NewInt(1), f.Mantissa-1)
+	return f.compose(false, f.maxExponent(), quiet)
+}
+
+/**
+Internals
+*/
+
+func (f FloatFormat) sanityCheck() {
+	if f.Exponent < 2 || f.Exponent > 30 {
+		panic(fmt.Sprintf("invalid floating point exponent width: %d - must be in [2, 30]", f.Exponent))
+	}
+	if f.Mantissa == 0 {
+		panic("a floating point format requires at least one mantissa bit")
+	}
+}
+
+func (f FloatFormat) maxExponent() int {
+	return 1<<f.Exponent - 1
+}
+
+// fields splits the measurement into its sign, exponent, and mantissa fields.
+func (f FloatFormat) fields(m Measurement) (negative bool, exponent int, mantissa *big.Int) {
+	f.sanityCheck()
+
+	if m.Endianness != endian.Big {
+		m = m.ToEndian(endian.Big)
+	}
+	if m.BitWidth() != f.Width() {
+		panic(fmt.Sprintf("cannot decode a %d-bit measurement as a %d-bit %v", m.BitWidth(), f.Width(), f))
+	}
+
+	bits := m.GetAllBits()
+	negative = bits[0] == 1
+	for _, b := range bits[1 : 1+f.Exponent] {
+		exponent = exponent<<1 | int(b)
+	}
+	mantissa = new(big.Int)
+	for _, b := range bits[1+f.Exponent:] {
+		mantissa.Lsh(mantissa, 1)
+		mantissa.SetBit(mantissa, 0, uint(b))
+	}
+	return negative, exponent, mantissa
+}
+
+// compose builds a big endian measurement from the provided sign, exponent offset, and remaining field bits.
+//
+// NOTE: The field may carry into the exponent - which is how a rounded subnormal becomes the smallest normal.
+func (f FloatFormat) compose(negative bool, exponent int, field *big.Int) Measurement {
+	value := new(big.Int).Lsh(big.NewInt(int64(exponent)), f.Mantissa)
+	value.Or(value, field)
+	if negative {
+		value.SetBit(value, int(f.Exponent+f.Mantissa), 1)
+	}
+
+	bits := make([]Bit, f.Width())
+	for i := range bits {
+		bits[len(bits)-1-i] = Bit(value.Bit(i))
+	}
+	return NewMeasurement(bits...)
+}
+
+// roundHalfEven rounds the provided non-negative rational to the nearest integer, resolving ties to even.
+func roundHalfEven(r *big.Rat) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+
+	switch remainder.Lsh(remainder, 1).Cmp(r.Denom()) {
+	case 1:
+		quotient.Add(quotient, big.NewInt(1))
+	case 0:
+		if quotient.Bit(0) == 1 {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return quotient
+}
+
+// pow2 returns 2ⁿ as a rational.
+func pow2(n int) *big.Rat {
+	power := new(big.Int).Lsh(big.NewInt(1), uint(abs(n)))
+	if n < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), power)
+	}
+	return new(big.Rat).SetInt(power)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -8,6 +8,11 @@ func shouldRound(digit byte, base uint16) bool {
 	return digit > mid
 }
 
-func PrintDigit(digit byte) string {
-	return fmt.Sprintf("%02x", digit)
+// PrintDigit prints a single placeholder of the provided base - bases up to base₁₆ use a single hexadecimal
+// character, while larger bases use a two character hexadecimal token.
+func PrintDigit(digit byte, base uint16) string {
+	if base > 16 {
+		return fmt.Sprintf("%02x", digit)
+	}
+	return fmt.Sprintf("%x", digit)
 }
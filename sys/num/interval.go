@@ -0,0 +1,294 @@
+package num
+
+import (
+	"core/sys/atlas"
+	"fmt"
+	"math/big"
+)
+
+// An Interval is a closed range [lower, upper] which is guaranteed to contain the true value of a calculation.
+//
+// Irrational Realized numbers are cut off at their calculated precision, so any result derived from them is only
+// ever an approximation - and a Realized alone cannot say how good of one.  By carrying both bounds through every
+// operation, an Interval can instead report exactly how many placeholders of its result are guaranteed correct, and
+// how precisely its operands must be realized for the result to reach a target accuracy.
+//
+//	a := IntervalOf(&sqrt2)    // [1.41421356…, 1.41421357…]
+//	b := IntervalOf(&sqrt3)
+//	a.Mul(b).Placeholders()    // the guaranteed placeholders of √6
+//
+// NOTE: Intervals are immutable - every operation returns a new Interval.
+//
+// See IntervalOf, NewInterval, Interval.Placeholders, Interval.PrecisionFor, and Refine
+type Interval struct {
+	lower *big.Rat
+	upper *big.Rat
+	base  uint16
+}
+
+// NewInterval creates an Interval between the provided bounds, which may be given in either order, whose placeholders
+// are measured in the provided base - or base₁₀ if omitted.
+func NewInterval(lower, upper any, base ...uint16) Interval {
+	l, u := ToRat(lower), ToRat(upper)
+	if l.Cmp(u) > 0 {
+		l, u = u, l
+	}
+	return Interval{lower: l, upper: u, base: PanicIfInvalidBase(base...)}
+}
+
+// IntervalOf creates an Interval which is guaranteed to contain the provided operand.
+//
+// Rational operands - including Realized numbers which terminate or hold a periodic component - are exact, yielding
+// an Interval of zero width.  Irrational Realized numbers are widened by one unit of their final placeholder in
+// either direction, which bounds their true value regardless of whether the last placeholder was rounded or truncated.
+//
+// NOTE: The resulting Interval's placeholders are measured in the Realized number's base, or base₁₀ for all else.
+func IntervalOf(operand any) Interval {
+	switch typed := operand.(type) {
+	case Interval:
+		return typed
+	case *Realized:
+		return realizedInterval(typed)
+	case Realized:
+		return realizedInterval(&typed)
+	}
+
+	x := ToRat(operand)
+	return Interval{lower: x, upper: new(big.Rat).Set(x), base: 10}
+}
+
+// Lower returns a copy of the lower bound.
+func (i Interval) Lower() *big.Rat {
+	return new(big.Rat).Set(i.lower)
+}
+
+// Upper returns a copy of the upper bound.
+func (i Interval) Upper() *big.Rat {
+	return new(big.Rat).Set(i.upper)
+}
+
+// Midpoint returns the center of the Interval.
+func (i Interval) Midpoint() *big.Rat {
+	out := new(big.Rat).Add(i.lower, i.upper)
+	return out.Quo(out, big.NewRat(2, 1))
+}
+
+// Width returns the distance between the bounds - which is the largest possible error of the Midpoint, twice over.
+func (i Interval) Width() *big.Rat {
+	return new(big.Rat).Sub(i.upper, i.lower)
+}
+
+// Base returns the base which the Interval's placeholders are measured in.
+func (i Interval) Base() uint16 {
+	return i.base
+}
+
+// Exact returns whether the Interval holds a single value.
+func (i Interval) Exact() bool {
+	return i.lower.Cmp(i.upper) == 0
+}
+
+// Contains returns whether the provided operand lies within the Interval.
+func (i Interval) Contains(operand any) bool {
+	x := ToRat(operand)
+	return i.lower.Cmp(x) <= 0 && x.Cmp(i.upper) <= 0
+}
+
+// Realized returns the Midpoint as a static realized number in the Interval's base.
+func (i Interval) Realized() Realized {
+	return FromRat(i.Midpoint(), i.base)
+}
+
+/**
+Arithmetic
+*/
+
+// Add returns the Interval containing every sum of a value from each operand.
+//
+//	[𝑎, 𝑏] + [𝑐, 𝑑] = [𝑎 + 𝑐, 𝑏 + 𝑑]
+func (i Interval) Add(operand Interval) Interval {
+	return i.derive(new(big.Rat).Add(i.lower, operand.lower), new(big.Rat).Add(i.upper, operand.upper))
+}
+
+// Sub returns the Interval containing every difference of a value from each operand.
+//
+//	[𝑎, 𝑏] - [𝑐, 𝑑] = [𝑎 - 𝑑, 𝑏 - 𝑐]
+func (i Interval) Sub(operand Interval) Interval {
+	return i.derive(new(big.Rat).Sub(i.lower, operand.upper), new(big.Rat).Sub(i.upper, operand.lower))
+}
+
+// Neg returns the negated Interval.
+//
+//	-[𝑎, 𝑏] = [-𝑏, -𝑎]
+func (i Interval) Neg() Interval {
+	return i.derive(new(big.Rat).Neg(i.upper), new(big.Rat).Neg(i.lower))
+}
+
+// Mul returns the Interval containing every product of a value from each operand.
+//
+//	[𝑎, 𝑏] · [𝑐, 𝑑] = [min(𝑎𝑐, 𝑎𝑑, 𝑏𝑐, 𝑏𝑑), max(𝑎𝑐, 𝑎𝑑, 𝑏𝑐, 𝑏𝑑)]
+func (i Interval) Mul(operand Interval) Interval {
+	products := []*big.Rat{
+		new(big.Rat).Mul(i.lower, operand.lower),
+		new(big.Rat).Mul(i.lower, operand.upper),
+		new(big.Rat).Mul(i.upper, operand.lower),
+		new(big.Rat).Mul(i.upper, operand.upper),
+	}
+
+	lower, upper := products[0], products[0]
+	for _, p := range products[1:] {
+		if p.Cmp(lower) < 0 {
+			lower = p
+		}
+		if p.Cmp(upper) > 0 {
+			upper = p
+		}
+	}
+	return i.derive(lower, upper)
+}
+
+// Div returns the Interval containing every quotient of a value from each operand.
+//
+//	[𝑎, 𝑏] / [𝑐, 𝑑] = [𝑎, 𝑏] · [1/𝑑, 1/𝑐]
+//
+// NOTE: This will panic if the divisor contains zero, as the result would be unbounded.
+func (i Interval) Div(operand Interval) Interval {
+	if operand.lower.Sign() <= 0 && operand.upper.Sign() >= 0 {
+		panic("cannot divide by an interval containing zero")
+	}
+	reciprocal := Interval{
+		lower: new(big.Rat).Inv(operand.upper),
+		upper: new(big.Rat).Inv(operand.lower),
+		base:  operand.base,
+	}
+	return i.Mul(reciprocal)
+}
+
+/**
+Accuracy
+*/
+
+// Placeholders returns the number of fractional placeholders which are guaranteed correct - meaning both bounds
+// share every placeholder up to and including it.
+//
+//	[3.14626, 3.14628]  →  4
+//	[1.99996, 2.00024]  →  0
+//
+// NOTE: An Interval which straddles a placeholder boundary - such as one containing exactly 2 - may never reach
+// agreement, no matter how narrow it becomes.
+//
+// NOTE: Exact intervals are correct to every placeholder, so this is capped at atlas.Precision.
+func (i Interval) Placeholders() uint {
+	if i.lower.Sign() < 0 && i.upper.Sign() > 0 {
+		return 0
+	}
+	if i.Exact() {
+		return atlas.Precision
+	}
+
+	lower := new(big.Rat).Abs(i.lower)
+	upper := new(big.Rat).Abs(i.upper)
+	b := new(big.Rat).SetInt64(int64(i.base))
+
+	for placeholders := uint(0); ; placeholders++ {
+		if ratFloor(lower).Cmp(ratFloor(upper)) != 0 {
+			if placeholders == 0 {
+				return 0
+			}
+			return placeholders - 1
+		}
+		if placeholders == atlas.Precision {
+			return placeholders
+		}
+		lower.Mul(lower, b)
+		upper.Mul(upper, b)
+	}
+}
+
+// PrecisionFor estimates the precision an Interval's operands must be realized at for the result to reach the target
+// number of placeholders, given the precision they were realized at to produce it.
+//
+// Every basic operation propagates error linearly for small widths, so gaining 𝑛 more placeholders of accuracy in the
+// result requires realizing the operands to 𝑛 more placeholders.  One extra placeholder is included as a guard.
+//
+// NOTE: This is an estimate of accuracy - see Refine to realize a result until its placeholders are guaranteed.
+func (i Interval) PrecisionFor(target uint, precision uint) uint {
+	if i.Exact() {
+		return precision
+	}
+
+	// Find the smallest 𝑘 where the width ≤ 𝑏ᵏ
+	b := new(big.Rat).SetInt64(int64(i.base))
+	width := i.Width()
+	k := 0
+	if width.Cmp(big.NewRat(1, 1)) > 0 {
+		for width.Cmp(big.NewRat(1, 1)) > 0 {
+			width.Quo(width, b)
+			k++
+		}
+	} else {
+		for new(big.Rat).Mul(width, b).Cmp(big.NewRat(1, 1)) <= 0 {
+			width.Mul(width, b)
+			k--
+		}
+	}
+
+	out := int(precision) + k + int(target) + 1
+	if out < 0 {
+		return 0
+	}
+	return uint(out)
+}
+
+// Refine repeatedly computes an Interval at increasing operand precision until it holds the target number of
+// guaranteed placeholders, returning the result along with the precision which achieved it.
+//
+//	result, precision := Refine(50, func(precision uint) Interval {
+//		sqrt2.Precision(&precision)
+//		return IntervalOf(&sqrt2).Mul(IntervalOf(&sqrt3))
+//	})
+//
+// NOTE: Because a result may never agree across a placeholder boundary (see Interval.Placeholders), refinement stops
+// once the operand precision exceeds four times the target plus atlas.PrecisionMinimum - so please check the
+// resulting Interval's Placeholders before trusting it.
+func Refine(target uint, compute func(precision uint) Interval) (Interval, uint) {
+	limit := target*4 + atlas.PrecisionMinimum
+
+	precision := target
+	for {
+		result := compute(precision)
+		if result.Placeholders() >= target || precision >= limit {
+			return result, precision
+		}
+		precision = max(precision+1, result.PrecisionFor(target, precision))
+	}
+}
+
+// String returns the Interval's bounds printed to one placeholder beyond its guaranteed placeholders.
+func (i Interval) String() string {
+	width := int(min(i.Placeholders(), atlas.PrecisionMinimum*4)) + 1
+	lower, upper := FromRat(i.lower, i.base), FromRat(i.upper, i.base)
+	return fmt.Sprintf("[%s, %s]", lower.Print(width), upper.Print(width))
+}
+
+// derive creates a new Interval in this Interval's base.
+func (i Interval) derive(lower, upper *big.Rat) Interval {
+	return Interval{lower: lower, upper: upper, base: i.base}
+}
+
+// realizedInterval bounds a realized number by its final calculated placeholder.
+func realizedInterval(r *Realized) Interval {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
+	x := realizedToRat(r)
+	out := Interval{lower: x, upper: new(big.Rat).Set(x), base: r.base}
+	if r.irrational {
+		unit := new(big.Int).Exp(big.NewInt(int64(r.base)), big.NewInt(int64(r.fractionalWidth)), nil)
+		epsilon := new(big.Rat).SetFrac(big.NewInt(1), unit)
+		out.lower.Sub(out.lower, epsilon)
+		out.upper.Add(out.upper, epsilon)
+	}
+	return out
+}
@@ -0,0 +1,343 @@
+package num
+
+import (
+	"bytes"
+	"core/sys/entropy"
+	"encoding/binary"
+	"math/bits"
+)
+
+// A magnitude holds the value of a natural number's measurement as big endian bytes, without any leading zeros.
+// This lets arithmetic work directly upon the measured bytes, rather than re-encoding the value into another form.
+//
+// NOTE: Zero is held as an empty magnitude, and no operation ever modifies its operands.
+type magnitude []byte
+
+var (
+	magnitudeOne   = magnitude{1}
+	magnitudeTwo   = magnitude{2}
+	magnitudeThree = magnitude{3}
+)
+
+// magnitudeOf returns the magnitude of the provided measurement, left-padding any remaining bits to a whole byte.
+func magnitudeOf(m Measurement) magnitude {
+	if len(m.Bits) == 0 {
+		return magnitude(m.Bytes).trim()
+	}
+
+	all := m.GetAllBits()
+	out := make(magnitude, (len(all)+7)/8)
+	offset := len(out)*8 - len(all)
+	for i, b := range all {
+		if b == 1 {
+			out[(offset+i)/8] |= 0x80 >> ((offset + i) % 8)
+		}
+	}
+	return out.trim()
+}
+
+// magnitudeOfUint returns the magnitude of the provided value.
+func magnitudeOfUint(value uint64) magnitude {
+	out := make(magnitude, 8)
+	binary.BigEndian.PutUint64(out, value)
+	return out.trim()
+}
+
+// natural creates a natural number of the magnitude, measured to its minimum bit width.
+func (a magnitude) natural() Natural {
+	if len(a) == 0 {
+		return Natural{NewMeasurement(0)}
+	}
+
+	leading := bits.LeadingZeros8(a[0])
+	first := make([]Bit, 0, 8-leading)
+	for i := 7 - leading; i >= 0; i-- {
+		first = append(first, Bit(a[0]>>i&1))
+	}
+	return Natural{NewMeasurement(first...).AppendBytes(a[1:]...)}
+}
+
+/**
+Inspection
+*/
+
+// trim removes any leading zero bytes.
+func (a magnitude) trim() magnitude {
+	for len(a) > 0 && a[0] == 0 {
+		a = a[1:]
+	}
+	return a
+}
+
+// isZero returns whether the magnitude is zero.
+func (a magnitude) isZero() bool {
+	return len(a) == 0
+}
+
+// isOne returns whether the magnitude is one.
+func (a magnitude) isOne() bool {
+	return len(a) == 1 && a[0] == 1
+}
+
+// low returns the least significant byte of the magnitude.
+func (a magnitude) low() byte {
+	if len(a) == 0 {
+		return 0
+	}
+	return a[len(a)-1]
+}
+
+// bitLen returns the number of bits required to hold the magnitude.
+func (a magnitude) bitLen() int {
+	if len(a) == 0 {
+		return 0
+	}
+	return len(a)*8 - bits.LeadingZeros8(a[0])
+}
+
+// bit returns the bit at the provided position, counting from the least significant bit.
+func (a magnitude) bit(i int) byte {
+	j := len(a) - 1 - i/8
+	if j < 0 {
+		return 0
+	}
+	return a[j] >> (i % 8) & 1
+}
+
+// trailingZeros returns the number of consecutive zero bits, counting from the least significant bit.
+//
+// NOTE: Zero has no trailing zeros.
+func (a magnitude) trailingZeros() int {
+	for i := len(a) - 1; i >= 0; i-- {
+		if a[i] != 0 {
+			return (len(a)-1-i)*8 + bits.TrailingZeros8(a[i])
+		}
+	}
+	return 0
+}
+
+// cmp compares the magnitudes, returning -1, 0, or +1.
+func (a magnitude) cmp(b magnitude) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return bytes.Compare(a, b)
+}
+
+/**
+Arithmetic
+*/
+
+// add returns 𝑎 + 𝑏.
+func (a magnitude) add(b magnitude) magnitude {
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+
+	out := make(magnitude, len(a)+1)
+	carry := uint(0)
+	for i := 0; i < len(a); i++ {
+		sum := uint(a[len(a)-1-i]) + carry
+		if i < len(b) {
+			sum += uint(b[len(b)-1-i])
+		}
+		out[len(out)-1-i] = byte(sum)
+		carry = sum >> 8
+	}
+	out[0] = byte(carry)
+	return out.trim()
+}
+
+// sub returns 𝑎 - 𝑏.
+//
+// NOTE: This will panic if 𝑏 > 𝑎, as naturals cannot hold a negative result.
+func (a magnitude) sub(b magnitude) magnitude {
+	if a.cmp(b) < 0 {
+		panic("cannot subtract a larger natural from a smaller one")
+	}
+
+	out := make(magnitude, len(a))
+	borrow := 0
+	for i := 0; i < len(a); i++ {
+		difference := int(a[len(a)-1-i]) - borrow
+		if i < len(b) {
+			difference -= int(b[len(b)-1-i])
+		}
+		borrow = 0
+		if difference < 0 {
+			difference += 256
+			borrow = 1
+		}
+		out[len(out)-1-i] = byte(difference)
+	}
+	return out.trim()
+}
+
+// difference returns |𝑎 - 𝑏|.
+func (a magnitude) difference(b magnitude) magnitude {
+	if a.cmp(b) < 0 {
+		return b.sub(a)
+	}
+	return a.sub(b)
+}
+
+// mul returns 𝑎·𝑏 using long multiplication over the bytes.
+func (a magnitude) mul(b magnitude) magnitude {
+	if a.isZero() || b.isZero() {
+		return magnitude{}
+	}
+
+	out := make(magnitude, len(a)+len(b))
+	for i := len(a) - 1; i >= 0; i-- {
+		carry := uint(0)
+		for j := len(b) - 1; j >= 0; j-- {
+			product := uint(out[i+j+1]) + uint(a[i])*uint(b[j]) + carry
+			out[i+j+1] = byte(product)
+			carry = product >> 8
+		}
+		out[i] = byte(carry)
+	}
+	return out.trim()
+}
+
+// lsh returns 𝑎·2ⁿ.
+func (a magnitude) lsh(n int) magnitude {
+	if a.isZero() {
+		return magnitude{}
+	}
+
+	shift := n % 8
+	out := make(magnitude, len(a)+n/8+1)
+	for i := len(a) - 1; i >= 0; i-- {
+		value := uint(a[i]) << shift
+		out[i+1] |= byte(value)
+		out[i] |= byte(value >> 8)
+	}
+	return out.trim()
+}
+
+// rsh returns ⌊𝑎 / 2ⁿ⌋.
+func (a magnitude) rsh(n int) magnitude {
+	if n/8 >= len(a) {
+		return magnitude{}
+	}
+
+	shift := n % 8
+	kept := a[:len(a)-n/8]
+	out := make(magnitude, len(kept))
+	for i := len(kept) - 1; i >= 0; i-- {
+		out[i] = kept[i] >> shift
+		if i > 0 && shift > 0 {
+			out[i] |= kept[i-1] << (8 - shift)
+		}
+	}
+	return out.trim()
+}
+
+// divMod returns the quotient and remainder of 𝑎 / 𝑏 using binary long division.
+//
+// NOTE: This will panic if 𝑏 is zero.
+func (a magnitude) divMod(b magnitude) (quotient magnitude, remainder magnitude) {
+	if b.isZero() {
+		panic("cannot divide by zero")
+	}
+	if a.cmp(b) < 0 {
+		return magnitude{}, a
+	}
+
+	if len(b) == 1 {
+		// A single byte divisor can be divided out a whole byte at a time
+		divisor := uint(b[0])
+		quotient = make(magnitude, len(a))
+		r := uint(0)
+		for i, d := range a {
+			current := r<<8 | uint(d)
+			quotient[i] = byte(current / divisor)
+			r = current % divisor
+		}
+		return quotient.trim(), magnitude{byte(r)}.trim()
+	}
+
+	// The working remainder never reaches 2𝑏, so a single extra byte of headroom is enough
+	quotient = make(magnitude, len(a))
+	working := make(magnitude, len(b)+1)
+	for i := 0; i < len(a)*8; i++ {
+		for j := 0; j < len(working)-1; j++ {
+			working[j] = working[j]<<1 | working[j+1]>>7
+		}
+		working[len(working)-1] = working[len(working)-1]<<1 | a[i/8]>>(7-i%8)&1
+
+		if working[0] == 0 && bytes.Compare(working[1:], b) < 0 {
+			continue
+		}
+		borrow := 0
+		for j := len(working) - 1; j >= 0; j-- {
+			difference := int(working[j]) - borrow
+			if k := j - 1; k >= 0 {
+				difference -= int(b[k])
+			}
+			borrow = 0
+			if difference < 0 {
+				difference += 256
+				borrow = 1
+			}
+			working[j] = byte(difference)
+		}
+		quotient[i/8] |= 0x80 >> (i % 8)
+	}
+	return quotient.trim(), working.trim()
+}
+
+// mod returns 𝑎 mod 𝑏.
+func (a magnitude) mod(b magnitude) magnitude {
+	_, r := a.divMod(b)
+	return r
+}
+
+// gcd returns the greatest common divisor of the magnitudes using Euclid's algorithm.
+func (a magnitude) gcd(b magnitude) magnitude {
+	for !b.isZero() {
+		a, b = b, a.mod(b)
+	}
+	return a
+}
+
+// modPow returns 𝑎ᵉ mod 𝑚 by squaring and multiplying across the exponent's bits.
+func (a magnitude) modPow(exponent magnitude, modulus magnitude) magnitude {
+	if modulus.isOne() {
+		return magnitude{}
+	}
+
+	base := a.mod(modulus)
+	out := magnitudeOne
+	for i := exponent.bitLen() - 1; i >= 0; i-- {
+		out = out.mul(out).mod(modulus)
+		if exponent.bit(i) == 1 {
+			out = out.mul(base).mod(modulus)
+		}
+	}
+	return out
+}
+
+/**
+Randomness
+*/
+
+// randomMagnitudeBelow returns a uniformly random magnitude in the half-open interval [0, limit) by rejection
+// sampling the bit width of limit from the global entropy source.
+func randomMagnitudeBelow(limit magnitude) magnitude {
+	width := limit.bitLen()
+	raw := make(magnitude, (width+63)/64*8)
+	for {
+		for i := 0; i < len(raw); i += 8 {
+			binary.BigEndian.PutUint64(raw[i:], entropy.Uint64())
+		}
+		out := raw.rsh(len(raw)*8 - width)
+		if out.cmp(limit) < 0 {
+			return out
+		}
+	}
+}
@@ -15,9 +15,12 @@ import (
 // NOTE: ALL measurements are processed in standard endian.Big form - however, at the time of measurement we
 // ALSO capture the original endianness of the stored value.  It can generally be ignored - but endian.Endianness
 // is still quite interesting if you care to investigate =)
+//
+// To measure a value exactly as it's laid out in memory, see NewMeasurementOf - to reorder the measured bytes, see
+// Measurement.ToEndian - and to get the typed value back out, see DecodeMeasurement.
 type Measurement struct {
-	// Endianness indicates the endian.Endianness of the data as it was
-	// originally stored before being measured in standard endian.Big form.
+	// Endianness indicates the endian.Endianness of the measured bytes.  Measurements created from
+	// bits or bytes are always endian.Big, while NewMeasurementOf records the layout it measured.
 	endian.Endianness
 
 	// Bytes holds complete byte data.
@@ -40,6 +43,9 @@ func (a Measurement) ToNaturalString(base ...uint16) (string, uint) {
 func (a Measurement) ToNaturalDigits(base ...uint16) []byte {
 	b := PanicIfInvalidBase(base...)
 
+	if a.BitWidth() == 0 {
+		return []byte{}
+	}
 	digits, _ := Base.StringToDigits(a.String(), 2, b)
 	return digits
 }
@@ -143,9 +149,9 @@ func NewMeasurementOfBinaryString(s string) Measurement {
 	bits := make([]Bit, len(s))
 	for i := 0; i < len(s); i++ {
 		if s[i] != '0' && s[i] != '1' {
-			panic(fmt.Sprintf("invalid character '%s' found in binary string", s[i]))
+			panic(fmt.Sprintf("invalid character '%c' found in binary string", s[i]))
 		}
-		bits[i] = Bit(s[i])
+		bits[i] = Bit(s[i] - '0')
 	}
 	return NewMeasurement(bits...)
 }
@@ -0,0 +1,186 @@
+package num
+
+import (
+	"core/enum/endian"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseMeasurement parses the Measurement.Print form of a measurement - bits separated by whitespace - back into a
+// Measurement.  Because whitespace is ignored, this will also accept a Measurement.String binary string.
+//
+// NOTE: Unlike NewMeasurementOfBinaryString, this returns an error rather than panicking on invalid input.
+func ParseMeasurement(s string) (Measurement, error) {
+	bits := make([]Bit, 0, len(s))
+	for _, r := range s {
+		switch r {
+		case '0':
+			bits = append(bits, 0)
+		case '1':
+			bits = append(bits, 1)
+		case ' ', '\t', '\n', '\r':
+		default:
+			return Measurement{}, fmt.Errorf("invalid character '%c' found in measurement", r)
+		}
+	}
+	return NewMeasurement(bits...), nil
+}
+
+/**
+Binary
+*/
+
+// MarshalBinary encodes the measurement in a compact binary form, satisfying encoding.BinaryMarshaler -
+//
+//	| bit width (uvarint) | packed bits (⌈width/8⌉ bytes) | endian.Endianness (1 byte) |
+//
+// The packed bits are stored in most→to→least significant order, with the final byte right-padded with 0s.
+func (a Measurement) MarshalBinary() ([]byte, error) {
+	a = a.sanityCheck()
+
+	width := a.BitWidth()
+	out := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(a.Bytes)+2), uint64(width))
+	out = append(out, a.Bytes...)
+
+	if len(a.Bits) > 0 {
+		var last byte
+		for i, b := range a.Bits {
+			last |= byte(b) << (7 - i)
+		}
+		out = append(out, last)
+	}
+	return append(out, byte(a.Endianness)), nil
+}
+
+// UnmarshalBinary decodes the output of MarshalBinary, satisfying encoding.BinaryUnmarshaler.
+func (a *Measurement) UnmarshalBinary(data []byte) error {
+	width, n := binary.Uvarint(data)
+	if n <= 0 {
+		return errors.New("invalid measurement bit width header")
+	}
+	data = data[n:]
+
+	byteCount := width / 8
+	bitCount := width % 8
+	packed := byteCount
+	if bitCount > 0 {
+		packed++
+	}
+	if uint64(len(data)) != packed+1 {
+		return fmt.Errorf("expected %d bytes for a %d-bit measurement, got %d", packed+1, width, len(data))
+	}
+
+	e := endian.Endianness(data[packed])
+	if e != endian.Little && e != endian.Big && e != endian.BitwiseLittle {
+		return fmt.Errorf("unknown endianness '%d'", data[packed])
+	}
+
+	bits := make([]Bit, bitCount)
+	if bitCount > 0 {
+		last := data[byteCount]
+		for i := range bits {
+			bits[i] = Bit((last >> (7 - i)) & 1)
+		}
+	}
+
+	*a = Measurement{
+		Endianness: e,
+		Bytes:      append([]byte{}, data[:byteCount]...),
+		Bits:       bits,
+	}
+	return nil
+}
+
+/**
+Text
+*/
+
+// MarshalText encodes the measurement using the same syntax as a single-measurement std.Phrase's StringPretty output,
+// satisfying encoding.TextMarshaler.  If the measurement isn't in standard endian.Big form, its endianness follows the
+// closing pipe -
+//
+//	| 0 1 0 0 1 1 0 1 |
+//	| 0 0 0 1 0 1 1 0 | LittleEndian
+func (a Measurement) MarshalText() ([]byte, error) {
+	builder := strings.Builder{}
+	builder.WriteString("| ")
+	builder.WriteString(a.Print())
+	builder.WriteString(" |")
+	if a.Endianness != endian.Big {
+		builder.WriteString(" ")
+		builder.WriteString(a.Endianness.String())
+	}
+	return []byte(builder.String()), nil
+}
+
+// UnmarshalText decodes the output of MarshalText, satisfying encoding.TextUnmarshaler.
+func (a *Measurement) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	if len(s) < 2 || s[0] != '|' {
+		return fmt.Errorf("measurement text must be wrapped in pipes: %q", s)
+	}
+
+	closing := strings.LastIndex(s, "|")
+	if closing == 0 {
+		return fmt.Errorf("measurement text must be wrapped in pipes: %q", s)
+	}
+
+	e := endian.Big
+	if suffix := strings.TrimSpace(s[closing+1:]); len(suffix) > 0 {
+		var err error
+		if e, err = endian.Parse(suffix); err != nil {
+			return err
+		}
+	}
+
+	m, err := ParseMeasurement(s[1:closing])
+	if err != nil {
+		return err
+	}
+	m.Endianness = e
+	*a = m
+	return nil
+}
+
+/**
+JSON
+*/
+
+type measurementJSON struct {
+	Endianness string `json:"endianness"`
+	Bits       string `json:"bits"`
+}
+
+// MarshalJSON encodes the measurement as a JSON object holding its endianness and binary string.
+//
+//	{"endianness":"BigEndian","bits":"0100110100101100"}
+func (a Measurement) MarshalJSON() ([]byte, error) {
+	return json.Marshal(measurementJSON{
+		Endianness: a.Endianness.String(),
+		Bits:       a.String(),
+	})
+}
+
+// UnmarshalJSON decodes the output of MarshalJSON.
+func (a *Measurement) UnmarshalJSON(data []byte) error {
+	var raw measurementJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e, err := endian.Parse(raw.Endianness)
+	if err != nil {
+		return err
+	}
+
+	m, err := ParseMeasurement(raw.Bits)
+	if err != nil {
+		return err
+	}
+	m.Endianness = e
+	*a = m
+	return nil
+}
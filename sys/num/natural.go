@@ -24,7 +24,7 @@ type Natural struct {
 //	Realized - the whole part of the realized number is captured and the base is ignored entirely
 //	complex64 or complex128 - this will panic, as a natural number cannot describe a complex number
 func ParseNatural(operand any, base ...uint16) Natural {
-	b := PanicIfInvalidBase(base[0])
+	b := PanicIfInvalidBase(base...)
 	op := ToString(FilterOperands(b, operand)[0])
 
 	if len(op) == 0 {
@@ -78,11 +78,11 @@ func (n Natural) String() string {
 }
 
 func (n Natural) Print(base ...uint16) string {
-	str, _ := n.measurement.ToNaturalString(PanicIfInvalidBase(base[0]))
+	str, _ := n.measurement.ToNaturalString(PanicIfInvalidBase(base...))
 	return str
 }
 
 func (n Natural) Matrix(width uint, base ...uint16) string {
-	str := n.Print(PanicIfInvalidBase(base[0]))
+	str := n.Print(PanicIfInvalidBase(base...))
 	return pad.String[rune](scheme.Tile, ordinal.Negative, width, str, "0")
 }
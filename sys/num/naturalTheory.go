@@ -0,0 +1,345 @@
+package num
+
+import "slices"
+
+// NOTE: Every operation in this file works directly upon the bytes of the natural's measurement - see magnitude.
+
+/**
+Division
+*/
+
+// DivMod returns the quotient and remainder of dividing this natural by the provided divisor.
+//
+//	𝑛 = 𝑞·𝑑 + 𝑟   where 0 ≤ 𝑟 < 𝑑
+//
+// NOTE: This will panic if the divisor is zero.
+func (n Natural) DivMod(divisor Natural) (quotient Natural, remainder Natural) {
+	d := divisor.nonZero("divide by")
+	q, r := magnitudeOf(n.measurement).divMod(d)
+	return q.natural(), r.natural()
+}
+
+// Mod returns the remainder of dividing this natural by the provided divisor.
+//
+// NOTE: This will panic if the divisor is zero.
+func (n Natural) Mod(divisor Natural) Natural {
+	_, r := n.DivMod(divisor)
+	return r
+}
+
+/**
+Divisors
+*/
+
+// A Signed natural pairs a natural magnitude with a sign, for results which may fall below zero.
+//
+// See Natural.ExtendedGCD
+type Signed struct {
+	Negative  bool
+	Magnitude Natural
+}
+
+// String - see.PrintingNumbers
+func (s Signed) String() string {
+	if s.Negative {
+		return "-" + s.Magnitude.String()
+	}
+	return s.Magnitude.String()
+}
+
+// GCD returns the greatest common divisor of this natural and the provided natural.
+//
+// NOTE: gcd(0, 0) is defined as 0.
+func (n Natural) GCD(m Natural) Natural {
+	return magnitudeOf(n.measurement).gcd(magnitudeOf(m.measurement)).natural()
+}
+
+// ExtendedGCD returns the greatest common divisor alongside Bézout's coefficients, which satisfy -
+//
+//	𝑛·𝑥 + 𝑚·𝑦 = gcd(𝑛, 𝑚)
+//
+// NOTE: The coefficients may be negative, so they're returned as Signed naturals.
+func (n Natural) ExtendedGCD(m Natural) (gcd Natural, x Signed, y Signed) {
+	g, xMagnitude, xNegative, yMagnitude, yNegative := extendedGCD(magnitudeOf(n.measurement), magnitudeOf(m.measurement))
+	return g.natural(), Signed{xNegative, xMagnitude.natural()}, Signed{yNegative, yMagnitude.natural()}
+}
+
+// LCM returns the least common multiple of this natural and the provided natural.
+//
+// NOTE: lcm(𝑛, 0) is defined as 0.
+func (n Natural) LCM(m Natural) Natural {
+	a, b := magnitudeOf(n.measurement), magnitudeOf(m.measurement)
+	if a.isZero() || b.isZero() {
+		return magnitude{}.natural()
+	}
+	q, _ := a.divMod(a.gcd(b))
+	return q.mul(b).natural()
+}
+
+// extendedGCD runs the extended Euclidean algorithm over magnitudes alone.  The coefficients of each step alternate
+// in sign, so their magnitudes only ever accumulate - 𝑠ᵢ₊₁ = 𝑠ᵢ₋₁ + 𝑞ᵢ·𝑠ᵢ - while the sign follows the step count.
+func extendedGCD(a, b magnitude) (gcd magnitude, x magnitude, xNegative bool, y magnitude, yNegative bool) {
+	s, sNext := magnitudeOne, magnitude{}
+	t, tNext := magnitude{}, magnitudeOne
+	steps := 0
+	for !b.isZero() {
+		q, r := a.divMod(b)
+		a, b = b, r
+		s, sNext = sNext, s.add(q.mul(sNext))
+		t, tNext = tNext, t.add(q.mul(tNext))
+		steps++
+	}
+
+	// 𝑠ᵢ carries the sign of (-1)ⁱ, while 𝑡ᵢ carries the sign of (-1)ⁱ⁺¹
+	odd := steps%2 == 1
+	return a, s, odd && !s.isZero(), t, !odd && !t.isZero()
+}
+
+/**
+Modular Arithmetic
+*/
+
+// ModPow returns this natural raised to the provided exponent, reduced by the provided modulus.
+//
+//	𝑛ᵉ mod 𝑚
+//
+// NOTE: This will panic if the modulus is zero.
+func (n Natural) ModPow(exponent Natural, modulus Natural) Natural {
+	m := modulus.nonZero("reduce by")
+	return magnitudeOf(n.measurement).modPow(magnitudeOf(exponent.measurement), m).natural()
+}
+
+// ModInverse returns the multiplicative inverse of this natural under the provided modulus, and whether one exists.
+//
+//	𝑛·𝑛⁻¹ ≡ 1 (mod 𝑚)
+//
+// NOTE: An inverse only exists when gcd(𝑛, 𝑚) = 1.
+//
+// NOTE: This will panic if the modulus is zero.
+func (n Natural) ModInverse(modulus Natural) (Natural, bool) {
+	m := modulus.nonZero("invert under")
+	g, x, negative, _, _ := extendedGCD(magnitudeOf(n.measurement).mod(m), m)
+	if !g.isOne() {
+		return magnitude{}.natural(), false
+	}
+
+	x = x.mod(m)
+	if negative && !x.isZero() {
+		x = m.sub(x)
+	}
+	return x.natural(), true
+}
+
+// Jacobi returns the Jacobi symbol (𝑛/𝑚), which is either -1, 0, or +1.
+//
+// NOTE: This will panic if the modulus is even.
+func (n Natural) Jacobi(m Natural) int {
+	b := magnitudeOf(m.measurement)
+	if b.low()&1 == 0 {
+		panic("the Jacobi symbol is only defined for odd moduli")
+	}
+
+	a := magnitudeOf(n.measurement).mod(b)
+	out := 1
+	for !a.isZero() {
+		// (2/𝑚) = -1 whenever 𝑚 ≡ 3 or 5 (mod 8)
+		zeros := a.trailingZeros()
+		a = a.rsh(zeros)
+		if residue := b.low() & 7; zeros%2 == 1 && (residue == 3 || residue == 5) {
+			out = -out
+		}
+
+		// Quadratic reciprocity flips the symbol when both are ≡ 3 (mod 4)
+		a, b = b, a
+		if a.low()&3 == 3 && b.low()&3 == 3 {
+			out = -out
+		}
+		a = a.mod(b)
+	}
+
+	if !b.isOne() {
+		return 0
+	}
+	return out
+}
+
+/**
+Roots
+*/
+
+// ISqrt returns the integer square root of this natural - the largest natural whose square does not exceed it.
+//
+//	⌊√𝑛⌋
+func (n Natural) ISqrt() Natural {
+	value := magnitudeOf(n.measurement)
+	if value.isZero() {
+		return value.natural()
+	}
+
+	// Newton's method descends monotonically from any starting point at or above the root
+	x := magnitudeOne.lsh((value.bitLen() + 1) / 2)
+	for {
+		q, _ := value.divMod(x)
+		next := x.add(q).rsh(1)
+		if next.cmp(x) >= 0 {
+			return x.natural()
+		}
+		x = next
+	}
+}
+
+/**
+Primes
+*/
+
+// smallPrimes are trial divided before any heavier test is performed, and double as the deterministic Miller–Rabin
+// witnesses - which are sufficient to prove primality of every value below 3.317×10²⁴.
+var smallPrimes = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// IsPrime reports whether this natural is prime using the Miller–Rabin test.
+//
+// The first twelve primes are always used as witnesses, making the result exact for every value below 3.317×10²⁴.
+// Beyond that, the provided number of additional random witnesses (or 20 if omitted) are drawn from the global
+// entropy source - each of which reduces the chance of a composite passing by at least a factor of four.
+//
+// See entropy.Seed
+func (n Natural) IsPrime(rounds ...uint) bool {
+	r := uint(20)
+	if len(rounds) > 0 {
+		r = rounds[0]
+	}
+	return millerRabin(magnitudeOf(n.measurement), r)
+}
+
+// Factor returns the prime factorization of this natural in ascending order, with repeated factors listed repeatedly.
+//
+//	Factor(360)  // [2 2 2 3 3 5]
+//
+// Small factors are found by trial division, while the remainder is split using Pollard's rho algorithm.
+//
+// NOTE: The factorization of 1 is empty.
+//
+// NOTE: This will panic if the natural is zero.
+func (n Natural) Factor() []Natural {
+	value := n.nonZero("factor")
+
+	factors := make([]magnitude, 0)
+	for _, p := range smallPrimes {
+		prime := magnitudeOfUint(p)
+		for {
+			q, r := value.divMod(prime)
+			if !r.isZero() {
+				break
+			}
+			factors = append(factors, prime)
+			value = q
+		}
+	}
+	factors = append(factors, factorRho(value)...)
+
+	slices.SortFunc(factors, magnitude.cmp)
+	out := make([]Natural, len(factors))
+	for i, f := range factors {
+		out[i] = f.natural()
+	}
+	return out
+}
+
+// millerRabin tests the provided value against every small prime witness, followed by the provided number of
+// random witnesses.
+func millerRabin(n magnitude, rounds uint) bool {
+	if n.cmp(magnitudeTwo) < 0 {
+		return false
+	}
+	for _, p := range smallPrimes {
+		prime := magnitudeOfUint(p)
+		if n.cmp(prime) == 0 {
+			return true
+		}
+		if n.mod(prime).isZero() {
+			return false
+		}
+	}
+
+	// 𝑛 - 1 = 𝑑·2ˢ
+	nMinusOne := n.sub(magnitudeOne)
+	s := nMinusOne.trailingZeros()
+	d := nMinusOne.rsh(s)
+
+	witness := func(a magnitude) bool {
+		x := a.modPow(d, n)
+		if x.isOne() || x.cmp(nMinusOne) == 0 {
+			return true
+		}
+		for i := 1; i < s; i++ {
+			x = x.mul(x).mod(n)
+			if x.cmp(nMinusOne) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, p := range smallPrimes {
+		if !witness(magnitudeOfUint(p)) {
+			return false
+		}
+	}
+
+	// Random witnesses are drawn from [2, 𝑛 - 2]
+	span := n.sub(magnitudeThree)
+	for i := uint(0); i < rounds; i++ {
+		if !witness(randomMagnitudeBelow(span).add(magnitudeTwo)) {
+			return false
+		}
+	}
+	return true
+}
+
+// factorRho recursively splits the provided value - which must hold no small prime factors - into its prime factors.
+func factorRho(n magnitude) []magnitude {
+	if n.isOne() {
+		return nil
+	}
+	if millerRabin(n, 20) {
+		return []magnitude{n}
+	}
+
+	d := pollardRho(n)
+	q, _ := n.divMod(d)
+	return append(factorRho(d), factorRho(q)...)
+}
+
+// pollardRho finds a non-trivial divisor of the provided odd composite using Floyd's cycle detection over the
+// pseudo-random sequence 𝑥ᵢ₊₁ = 𝑥ᵢ² + 𝑐 (mod 𝑛).  If a walk fails, another is started from a fresh 𝑥₀ and 𝑐.
+func pollardRho(n magnitude) magnitude {
+	span := n.sub(magnitudeOne)
+	for {
+		c := randomMagnitudeBelow(span).add(magnitudeOne)
+		x := randomMagnitudeBelow(n)
+		y := x
+		d := magnitudeOne
+
+		step := func(v magnitude) magnitude {
+			return v.mul(v).add(c).mod(n)
+		}
+
+		for d.isOne() {
+			x = step(x)
+			y = step(step(y))
+			d = x.difference(y).gcd(n)
+		}
+		if d.cmp(n) != 0 {
+			return d
+		}
+	}
+}
+
+// nonZero returns the natural's magnitude, or panics if it's zero.
+func (n Natural) nonZero(action string) magnitude {
+	value := magnitudeOf(n.measurement)
+	if value.isZero() {
+		panic("cannot " + action + " zero")
+	}
+	return value
+}
@@ -1,12 +1,13 @@
 package num
 
 import (
+	"core/enum/overflow"
 	"fmt"
 )
 
 // NewNumericBounded creates a new instance of Numeric[T] bounded in the closed interval [minimum, maximum] and returns the initial set operation's Breach.
 //
-// See Numeric, NewNumeric, and NewNumericBounded
+// See Numeric, NewNumeric, NewNumericBounded, and NewNumericWithPolicy
 func NewNumericBounded[T Advanced](value, minimum, maximum T, clamp ...bool) (Numeric[T], Breach) {
 	c := len(clamp) > 0 && clamp[0]
 	var zero T
@@ -18,15 +19,35 @@ func NewNumericBounded[T Advanced](value, minimum, maximum T, clamp ...bool) (Nu
 		maximum:     maximum,
 		initialized: true,
 		unbounded:   false,
+		observers:   newObservers[T](),
 		Clamp:       c,
 	}
 	err := b.Set(value)
 	return b, err
 }
 
+// NewNumericWithPolicy creates a new instance of Numeric[T] bounded in the closed interval [minimum, maximum], which
+// follows the provided overflow policy, and returns the initial set operation's Breach.
+//
+// NOTE: The initial value is also subject to the policy - so overflow.Panic will panic if it's out of bounds.
+//
+// See Numeric, NewNumeric, NewNumericBounded, and NewNumericWithPolicy
+func NewNumericWithPolicy[T Advanced](value, minimum, maximum T, policy overflow.Policy) (Numeric[T], Breach) {
+	b := Numeric[T]{
+		minimum:     minimum,
+		maximum:     maximum,
+		initialized: true,
+		unbounded:   false,
+		observers:   newObservers[T](),
+		Overflow:    policy,
+	}
+	err := b.Set(value)
+	return b, err
+}
+
 // NewNumeric creates a new instance of Numeric[T] which does not intercept the provided types boundaries.
 //
-// See Numeric, NewNumeric, and NewNumericBounded
+// See Numeric, NewNumeric, NewNumericBounded, and NewNumericWithPolicy
 func NewNumeric[T Advanced](value T) Numeric[T] {
 	switch raw := any(value).(type) {
 	case Natural, Realized, complex64, complex128:
@@ -76,6 +97,7 @@ func newNumericPrimitive[T Primitive](value T) Numeric[T] {
 		maximum:     MaxValue[T](),
 		initialized: true,
 		unbounded:   true,
+		observers:   newObservers[T](),
 		Clamp:       false,
 	}
 	_ = b.Set(value)
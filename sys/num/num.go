@@ -1,10 +1,7 @@
 package num
 
 import (
-	"core/enum/direction/ordinal"
 	"core/sys/atlas"
-	"core/sys/pad"
-	"core/sys/pad/scheme"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -38,64 +35,6 @@ func TypeAssert[TOut Primitive](value any) TOut {
 	panic("cannot cast non-primitive types")
 }
 
-func ToStringAligned(operands ...any) []string {
-	if !IsNumeric(operands...) {
-		panic("cannot align non Numeric-compatible types")
-	}
-
-	if IsComplex(operands...) {
-		panic("cannot align complex numbers")
-	}
-
-	if IsNaN(operands...) {
-		panic("cannot align NaN")
-	}
-
-	sign := 1
-	whole := 2
-	fractional := 4
-
-	matrix := make([][]string, len(operands))
-	var widestWhole int
-	var widestFractional int
-
-	for i, v := range operands {
-		inf, _ := IsInf(v)
-		if inf {
-			panic("cannot align Inf")
-		}
-		str := ToString(v)
-		parts := decimalPattern.FindStringSubmatch(str)
-		if parts == nil {
-			panic("unknown input type for alignment")
-		}
-
-		// NOTE: This ensures we don't get a '-0' in the output
-		if allZerosPattern.MatchString(str) && len(parts[sign]) > 0 {
-			parts[sign] = ""
-		}
-		if len(parts[sign]) == 0 {
-			parts[sign] = " "
-		}
-
-		matrix[i] = parts
-		if len(parts[whole]) > widestWhole {
-			widestWhole = len(parts[whole])
-		}
-		if len(parts[fractional]) > widestFractional {
-			widestFractional = len(parts[fractional])
-		}
-	}
-
-	out := make([]string, len(operands))
-	for i, v := range matrix {
-		matrix[i][whole] = pad.String[rune](scheme.Tile, ordinal.Negative, uint(widestWhole), v[whole], "0")
-		matrix[i][fractional] = pad.String[rune](scheme.Tile, ordinal.Positive, uint(widestFractional), v[fractional], "0")
-		out[i] = matrix[i][sign] + matrix[i][whole] + "." + matrix[i][fractional]
-	}
-	return out
-}
-
 // ToString uses strconv to format a string representation of the number in base₁₀.
 // The output will be a decimal value and not in notation form, using strconv's 'f' format whenever possible.
 //
@@ -113,6 +52,8 @@ func ToString(value any) string {
 		return typed.Print()
 	case Realization:
 		return typed.String()
+	case Snapshot:
+		return typed.String()
 	case Realized:
 		return typed.Print(typed.base)
 	case *big.Int:
@@ -170,7 +111,7 @@ Error messages
 // PanicIfInvalidBase will return base₁₀ if no input is provided, or panic if it's not in the closed set [base₂, base₂₅₆]
 func PanicIfInvalidBase(base ...uint16) uint16 {
 	b := uint16(10)
-	if len(base) == 0 {
+	if len(base) > 0 {
 		if base[0] < 2 || base[0] > 256 {
 			panic(fmt.Errorf("invalid base '%d' - must be between 2 and 256", base[0]))
 		}
@@ -0,0 +1,324 @@
+package num
+
+import (
+	"core/enum/sign"
+	"core/sys/atlas"
+	"core/sys/num/internal"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// NumberFormat describes how a number's placeholders are grouped, how its fractional part is marked, and how its
+// sign is written - allowing numbers to be printed and parsed in regional conventions.
+//
+//	FormatWestern     → "1,234,567.89"
+//	FormatContinental → "1.234.567,89"
+//	FormatIndian      → "12,34,567.89"
+//	FormatSI          → "1 234 567,89"
+//
+// GroupSizes are read outward from the decimal mark, with the final size repeating for the rest of the whole part -
+// so {3} groups by thousands, while {3, 2} groups the first thousand and then every hundred after it.  Grouping is
+// disabled if either GroupSeparator or GroupSizes is empty.
+//
+// NOTE: Bases above 16 still follow the spaced-placeholder convention of see.PrintingNumbers - meaning every
+// placeholder, separator, and mark is its own space-delimited component.
+//
+// See FormatPlain, FormatWestern, FormatContinental, FormatIndian, FormatSI, and sign.Style
+type NumberFormat struct {
+	GroupSeparator string
+	GroupSizes     []uint
+	DecimalMark    string
+	Sign           sign.Style
+}
+
+var (
+	// FormatPlain is the standard ungrouped see.PrintingNumbers form.
+	FormatPlain = NumberFormat{DecimalMark: "."}
+
+	// FormatWestern groups by thousands with commas and marks the fractional part with a period - "1,234,567.89"
+	FormatWestern = NumberFormat{GroupSeparator: ",", GroupSizes: []uint{3}, DecimalMark: "."}
+
+	// FormatContinental groups by thousands with periods and marks the fractional part with a comma - "1.234.567,89"
+	FormatContinental = NumberFormat{GroupSeparator: ".", GroupSizes: []uint{3}, DecimalMark: ","}
+
+	// FormatIndian groups the first thousand and then every hundred with commas - "12,34,567.89"
+	FormatIndian = NumberFormat{GroupSeparator: ",", GroupSizes: []uint{3, 2}, DecimalMark: "."}
+
+	// FormatSI groups by thousands with spaces and marks the fractional part with a comma - "1 234 567,89"
+	FormatSI = NumberFormat{GroupSeparator: " ", GroupSizes: []uint{3}, DecimalMark: ","}
+)
+
+// PrintFormatted prints the natural number in the provided format and base, or base₁₀ if omitted.
+//
+// See NumberFormat
+func (n Natural) PrintFormatted(format NumberFormat, base ...uint16) string {
+	b := PanicIfInvalidBase(base...)
+	format.sanityCheck()
+	return format.compose(false, false, intToDigits(n.toInt(), b), nil, nil, b)
+}
+
+// PrintFormatted prints the realized number in the provided format and base, or base₁₀ if omitted.
+//
+// Just as with Print, a fractionalWidth of '-1' prints the value to whatever precision it's currently calculated out to.
+// Otherwise, the fractional part is rounded half away from zero or right-padded with zeros to the requested width - and,
+// if rounding lost any information, the output is marked approximate with a tilde [~].
+//
+// See NumberFormat
+func (r *Realized) PrintFormatted(format NumberFormat, fractionalWidth int, base ...uint16) string {
+	b := r.sanityCheck(base...)
+	format.sanityCheck()
+
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
+	if fractionalWidth < 0 && b == r.base {
+		whole, fractional, periodic := r.Digits()
+		return format.compose(r.irrational, r.negative, whole, fractional, periodic, b)
+	}
+
+	x := realizedToRat(r)
+	if fractionalWidth < 0 {
+		// A different base was requested, so re-expand the value to the current precision
+		realization := expandRat(x, b, *r.precision)
+		return format.compose(r.irrational || realization.Irrational, realization.Negative, realization.Whole, realization.Fractional, realization.Periodic, b)
+	}
+
+	width := uint(fractionalWidth)
+	scaled := new(big.Rat).Mul(new(big.Rat).Abs(x), ratPow(b, fractionalWidth))
+	rounded := ratFloor(new(big.Rat).Add(scaled, big.NewRat(1, 2)))
+	inexact := new(big.Rat).SetInt(rounded).Cmp(scaled) != 0
+
+	digits := padDigits(intToDigits(rounded, b), width+1)
+	split := uint(len(digits)) - width
+	return format.compose(r.irrational || inexact, x.Sign() < 0 && rounded.Sign() != 0, digits[:split], digits[split:], nil, b)
+}
+
+// Align aligns the provided operands in this format - see ToStringAlignedWith.
+func (f NumberFormat) Align(operands ...any) []string {
+	return ToStringAlignedWith(AlignOptions{Format: f}, operands...)
+}
+
+/**
+Parsing
+*/
+
+// Parse creates a static realized number from a string written in this format and the provided base, or base₁₀ if
+// omitted.  Every sign style is accepted, as are the tilde [~] irrational and overscore [‾] periodic markers.
+//
+//	FormatContinental.Parse("-1.234.567,89")   // -1234567.89
+//	FormatIndian.Parse("(12,34,567)")         // -1234567
+//
+// NOTE: Group separators are only accepted in the whole part, but their positions are not validated.
+//
+// NOTE: This will panic if the input is empty or holds characters which are not a part of the format.
+func (f NumberFormat) Parse(input string, base ...uint16) Realized {
+	b := PanicIfInvalidBase(base...)
+	f.sanityCheck()
+
+	irrational, negative, whole, fractional, periodic := f.parse(input, b)
+	if irrational {
+		scaled := digitsToInt(append(append([]byte{}, whole...), fractional...), b)
+		return irrationalOfScaled(scaled, negative, b, uint(len(fractional)))
+	}
+
+	zero := digitsToInt(whole, b).Sign() == 0 && digitsToInt(fractional, b).Sign() == 0 && digitsToInt(periodic, b).Sign() == 0
+	return Realized{&realized{
+		negative:        negative && !zero,
+		whole:           naturalOfDigits(whole, b),
+		fractional:      naturalOfDigits(fractional, b),
+		periodic:        naturalOfDigits(periodic, b),
+		fractionalWidth: uint(len(fractional)),
+		periodicWidth:   uint(len(periodic)),
+		base:            b,
+		precision:       &atlas.Precision,
+		created:         true,
+	}}
+}
+
+// ParseNatural creates a natural number from a string written in this format and the provided base, or base₁₀ if
+// omitted.
+//
+// NOTE: This will panic if the input holds a negative sign, a fractional part, or an irrational marker.
+func (f NumberFormat) ParseNatural(input string, base ...uint16) Natural {
+	b := PanicIfInvalidBase(base...)
+	f.sanityCheck()
+
+	irrational, negative, whole, fractional, periodic := f.parse(input, b)
+	if irrational || len(fractional) > 0 || len(periodic) > 0 {
+		panic("a natural number cannot hold a fractional part")
+	}
+	if negative && digitsToInt(whole, b).Sign() != 0 {
+		panic("a natural number cannot be negative")
+	}
+	return naturalOfDigits(whole, b)
+}
+
+// parse splits the input into its markers and placeholder digits.
+func (f NumberFormat) parse(input string, base uint16) (irrational bool, negative bool, whole []byte, fractional []byte, periodic []byte) {
+	str := strings.TrimSpace(input)
+	if strings.HasPrefix(str, "~") {
+		irrational = true
+		str = strings.TrimSpace(str[len("~"):])
+	}
+
+	switch {
+	case strings.HasPrefix(str, "(") && strings.HasSuffix(str, ")"):
+		negative = true
+		str = str[1 : len(str)-1]
+	case strings.HasPrefix(str, "-"):
+		negative = true
+		str = str[1:]
+	case strings.HasPrefix(str, "+"):
+		str = str[1:]
+	}
+
+	wholeStr, fractionalStr, hasMark := strings.Cut(str, f.DecimalMark)
+	if separator := strings.TrimSpace(f.GroupSeparator); base > 16 {
+		// Whitespace separators are consumed alongside the spaced placeholders
+		components := strings.Fields(wholeStr)
+		wholeStr = ""
+		for _, c := range components {
+			if c != separator {
+				wholeStr += " " + c
+			}
+		}
+	} else if len(f.GroupSeparator) > 0 {
+		wholeStr = strings.ReplaceAll(wholeStr, f.GroupSeparator, "")
+	}
+	fractionalStr, periodicStr, _ := strings.Cut(fractionalStr, "‾")
+
+	whole = parseDigits(wholeStr, base)
+	fractional = parseDigits(fractionalStr, base)
+	periodic = parseDigits(periodicStr, base)
+
+	if len(whole)+len(fractional)+len(periodic) == 0 {
+		panic("cannot parse a number without any placeholders")
+	}
+	if len(whole) == 0 && hasMark {
+		whole = []byte{0}
+	}
+	return irrational, negative, whole, fractional, periodic
+}
+
+// parseDigits reads the placeholders of a single component - individual characters for bases up to 16, or
+// space-delimited two-character components above it.
+func parseDigits(str string, base uint16) []byte {
+	var placeholders []string
+	if base > 16 {
+		placeholders = strings.Fields(str)
+	} else {
+		placeholders = strings.Split(strings.TrimSpace(str), "")
+	}
+
+	out := make([]byte, 0, len(placeholders))
+	for _, p := range placeholders {
+		d, err := strconv.ParseUint(p, 16, 8)
+		if err != nil || uint16(d) >= base {
+			panic("invalid base" + strconv.Itoa(int(base)) + " placeholder '" + p + "'")
+		}
+		out = append(out, byte(d))
+	}
+	return out
+}
+
+/**
+Composition
+*/
+
+// compose writes the provided components in this format.
+func (f NumberFormat) compose(approximate, negative bool, whole, fractional, periodic []byte, base uint16) string {
+	printed := func(digits []byte) []string {
+		out := make([]string, len(digits))
+		for i, d := range digits {
+			out[i] = internal.PrintDigit(d, base)
+		}
+		return out
+	}
+
+	zero := true
+	for _, digits := range [][]byte{whole, fractional, periodic} {
+		for _, d := range digits {
+			zero = zero && d == 0
+		}
+	}
+
+	body := f.join(f.group(printed(whole)), printed(fractional), printed(periodic), base)
+
+	var prefix []string
+	if approximate {
+		prefix = append(prefix, "~")
+	}
+	var suffix []string
+	switch {
+	case negative && f.Sign == sign.Accounting:
+		prefix = append(prefix, "(")
+		suffix = append(suffix, ")")
+	case negative:
+		prefix = append(prefix, "-")
+	case f.Sign == sign.Explicit && !zero:
+		prefix = append(prefix, "+")
+	}
+
+	if base > 16 {
+		return strings.Join(append(append(prefix, body), suffix...), " ")
+	}
+	return strings.Join(prefix, "") + body + strings.Join(suffix, "")
+}
+
+// join writes the grouped whole placeholders alongside the fractional and periodic placeholders.
+func (f NumberFormat) join(whole, fractional, periodic []string, base uint16) string {
+	components := whole
+	if len(fractional) > 0 || len(periodic) > 0 {
+		components = append(components, f.DecimalMark)
+		components = append(components, fractional...)
+		if len(periodic) > 0 {
+			components = append(components, "‾")
+			components = append(components, periodic...)
+		}
+	}
+
+	if base > 16 {
+		return strings.Join(components, " ")
+	}
+	return strings.Join(components, "")
+}
+
+// group inserts the group separator between the provided whole placeholders.
+func (f NumberFormat) group(whole []string) []string {
+	if len(f.GroupSeparator) == 0 || len(f.GroupSizes) == 0 {
+		return whole
+	}
+
+	// Walk outward from the decimal mark, then reverse the result
+	out := make([]string, 0, len(whole)*2)
+	size, count := 0, 0
+	for i := len(whole) - 1; i >= 0; i-- {
+		if count == int(f.GroupSizes[min(size, len(f.GroupSizes)-1)]) {
+			out = append(out, f.GroupSeparator)
+			size++
+			count = 0
+		}
+		out = append(out, whole[i])
+		count++
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out
+}
+
+// sanityCheck panics if the format cannot be printed or parsed unambiguously.
+func (f NumberFormat) sanityCheck() {
+	if len(f.DecimalMark) == 0 {
+		panic("a number format requires a decimal mark")
+	}
+	if f.GroupSeparator == f.DecimalMark {
+		panic("a number format cannot use its decimal mark as a group separator")
+	}
+	for _, s := range f.GroupSizes {
+		if s == 0 {
+			panic("a number format cannot hold an empty group")
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package num
 
 import (
+	"core/enum/overflow"
 	"fmt"
 	"math"
 	"math/big"
@@ -10,15 +11,37 @@ import (
 // Additionally, all bounded types can be 'clamped' into the bounded range - meaning that
 // they will not automatically overflow or underflow when they exceed the bounds.
 //
+// For finer control, the Overflow policy may instead wrap, clamp, reflect, panic, or simply report
+// when the value breaches its boundaries.  When the policy is left as overflow.Unset, the Clamp flag
+// is still honored - any other policy takes precedence over it.
+//
+// Changes may be observed through callbacks, channels, or potentials - see Numeric.Subscribe.
+//
 // NOTE: All set operations return an error with the amount that the value overflowed or underflowed - otherwise nil.
+//
+// See overflow.Policy
 type Numeric[T Advanced] struct {
 	value       T
 	minimum     T
 	maximum     T
 	initialized bool
 	unbounded   bool
+	reversed    bool
+	observers   *observers[T]
+
+	Clamp    bool
+	Overflow overflow.Policy
+}
 
-	Clamp bool
+// policy returns the effective overflow policy, deferring to Clamp when the policy is left as overflow.Unset.
+func (bnd *Numeric[T]) policy() overflow.Policy {
+	if bnd.Overflow != overflow.Unset {
+		return bnd.Overflow
+	}
+	if bnd.Clamp {
+		return overflow.Clamp
+	}
+	return overflow.Wrap
 }
 
 func (bnd *Numeric[T]) sanityCheck() {
@@ -235,12 +258,6 @@ func (bnd *Numeric[T]) Random() T {
 	}
 }
 
-func randomAdvanced[T Advanced](bnd *Numeric[T]) T {
-	var zero T
-	// TODO: Implement this
-	return zero
-}
-
 func randomPrimitive[T Primitive](bnd *Numeric[T]) T {
 	if bnd.unbounded {
 		return Random[T]()
@@ -252,6 +269,9 @@ func randomPrimitive[T Primitive](bnd *Numeric[T]) T {
 //
 // NOTE: If you provide a negative number, this will 'decrement'
 //
+// NOTE: Under the overflow.Reflect policy, every bounce off a boundary reverses the direction of subsequent increments
+// and decrements - so repeatedly incrementing will ping-pong between the boundaries.
+//
 // NOTE: This will return a safely ignorable 'under' or 'over' error if the value exceeded the boundaries.
 func (bnd *Numeric[T]) Increment(amount ...T) Breach {
 	var zero T
@@ -303,6 +323,9 @@ func incrementPrimitive[T Primitive](bnd *Numeric[T], amount ...T) Breach {
 	if len(amount) > 0 {
 		i = amount[0]
 	}
+	if bnd.reversed && bnd.policy() == overflow.Reflect {
+		return subtractPrimitive(bnd, i)
+	}
 	return bnd.Set(bnd.value + i)
 }
 
@@ -310,6 +333,9 @@ func incrementPrimitive[T Primitive](bnd *Numeric[T], amount ...T) Breach {
 //
 // NOTE: If you provide a negative number, this will 'increment'
 //
+// NOTE: Under the overflow.Reflect policy, every bounce off a boundary reverses the direction of subsequent increments
+// and decrements - so repeatedly decrementing will ping-pong between the boundaries.
+//
 // NOTE: This will return a safely ignorable 'under' or 'over' error if the value exceeded the boundaries.
 func (bnd *Numeric[T]) Decrement(amount ...T) Breach {
 	var zero T
@@ -361,7 +387,49 @@ func decrementPrimitive[T Primitive](bnd *Numeric[T], amount ...T) Breach {
 	if len(amount) > 0 {
 		i = amount[0]
 	}
-	return bnd.Set(bnd.value - i)
+	if bnd.reversed && bnd.policy() == overflow.Reflect {
+		return bnd.Set(bnd.value + i)
+	}
+	return subtractPrimitive(bnd, i)
+}
+
+// subtractPrimitive subtracts the amount from the bound value, catching unsigned values which would otherwise wrap
+// beneath zero before the overflow policy could see them.
+func subtractPrimitive[T Primitive](bnd *Numeric[T], amount T) Breach {
+	if bnd.unbounded || IsSigned(amount) || IsFloat(amount) || amount <= bnd.value {
+		return bnd.Set(bnd.value - amount)
+	}
+
+	previous := bnd.value
+	under := amount - (bnd.value - bnd.minimum)
+	breach := Breach("-" + ToString(under))
+
+	switch bnd.policy() {
+	case overflow.Wrap:
+		distance := bnd.maximum - bnd.minimum + 1
+		if distance == 0 {
+			// The bounds span the entire type, so native wraparound is already correct
+			bnd.value -= amount
+		} else {
+			bnd.value = bnd.maximum - T(uint64(under-1)%uint64(distance))
+		}
+	case overflow.Clamp:
+		bnd.value = bnd.minimum
+	case overflow.Reflect:
+		value, reversed := reflectOffset(new(big.Int).Neg(new(big.Int).SetUint64(uint64(under))), bnd.minimum, bnd.maximum)
+		if reversed {
+			bnd.reversed = !bnd.reversed
+		}
+		bnd.value = value
+	case overflow.Panic:
+		panic(fmt.Sprintf("%v - %v breached the boundaries [%v, %v] by %v", ToString(bnd.value), ToString(amount), ToString(bnd.minimum), ToString(bnd.maximum), breach))
+	default:
+		// Report cannot hold a negative unsigned value, so it retains the native wraparound
+		bnd.value -= amount
+	}
+
+	bnd.emit(previous, breach)
+	return breach
 }
 
 // AddOrSubtract adds or subtracts the provided amount to the bound value.
@@ -440,9 +508,11 @@ func (bnd *Numeric[T]) SetAll(value, minimum, maximum T, clamp ...bool) Breach {
 	if Compare(minimum, maximum) == 1 {
 		minimum, maximum = maximum, minimum
 	}
+	previousMinimum, previousMaximum := bnd.minimum, bnd.maximum
 	bnd.minimum = minimum
 	bnd.maximum = maximum
 	bnd.Clamp = c
+	bnd.emitBoundaries(previousMinimum, previousMaximum)
 	return bnd.Set(value)
 }
 
@@ -482,8 +552,10 @@ func (bnd *Numeric[T]) SetBoundaries(minimum, maximum T) Breach {
 	if Compare(minimum, maximum) == 1 {
 		minimum, maximum = maximum, minimum
 	}
+	previousMinimum, previousMaximum := bnd.minimum, bnd.maximum
 	bnd.minimum = minimum
 	bnd.maximum = maximum
+	bnd.emitBoundaries(previousMinimum, previousMaximum)
 	return bnd.Set(bnd.value)
 }
 
@@ -686,6 +758,8 @@ func (bnd *Numeric[T]) SetUsingAny(value any) (Breach, error) {
 // the value overflows and underflows.  The amount that the value over and underflows is returned as a Breach string, which is
 // empty if the value did not breach the boundaries.  If the number is unbounded, this simply sets the value and returns an
 // empty breach.
+//
+// NOTE: The Overflow policy takes precedence over Clamp, unless left as overflow.Unset - see overflow.Policy
 func (bnd *Numeric[T]) Set(value T) Breach {
 	switch raw := any(value).(type) {
 	case Natural, Realized, complex64, complex128:
@@ -731,109 +805,185 @@ func setAdvanced[T Advanced](bnd *Numeric[T], value T) Breach {
 func setPrimitive[T Primitive](bnd *Numeric[T], value T) Breach {
 	bnd.sanityCheck()
 
+	previous := bnd.value
 	if bnd.unbounded {
 		bnd.value = value
+		bnd.emit(previous, "")
 		return ""
 	}
 
+	value, breach, reversed := boundPrimitive(value, bnd.minimum, bnd.maximum, bnd.policy())
+	if breach != "" && bnd.policy() == overflow.Panic {
+		panic(fmt.Sprintf("%v breached the boundaries [%v, %v] by %v", ToString(value), ToString(bnd.minimum), ToString(bnd.maximum), breach))
+	}
+	if reversed {
+		bnd.reversed = !bnd.reversed
+	}
+
+	bnd.value = value
+	bnd.emit(previous, breach)
+	return breach
+}
+
+// boundPrimitive applies the overflow policy to the value against the closed set [minimum, maximum] - returning the
+// bounded value, the amount it breached the boundaries, and whether a reflection reversed its direction of travel.
+//
+// NOTE: The Panic policy is bounded like Report - the caller is expected to panic upon a breach.
+func boundPrimitive[T Primitive](value, minimum, maximum T, policy overflow.Policy) (T, Breach, bool) {
 	var breach Breach
+	if value > maximum {
+		breach = Breach(ToString(value - maximum))
+	} else if value < minimum {
+		breach = Breach("-" + ToString(minimum-value))
+	}
 
-	if bnd.Clamp {
-		if value > bnd.maximum {
-			overflow := value - bnd.maximum
-			value = bnd.maximum
-			breach = Breach(ToString(overflow))
-		} else if value < bnd.minimum {
-			underflow := bnd.minimum - value
-			value = bnd.minimum
-			breach = Breach("-" + ToString(underflow))
+	switch policy {
+	case overflow.Report, overflow.Panic:
+		return value, breach, false
+	case overflow.Reflect:
+		if breach == "" {
+			return value, breach, false
 		}
-	} else {
-		if value > bnd.maximum {
-			overflow := value - bnd.maximum
-			breach = Breach(ToString(overflow))
+		value, reversed := reflectPrimitive(value, minimum, maximum)
+		return value, breach, reversed
+	case overflow.Clamp:
+		if value > maximum {
+			value = maximum
+		} else if value < minimum {
+			value = minimum
+		}
+		return value, breach, false
+	}
 
-			var zero T
-			if IsFloat(zero) {
-				r := bnd.maximum - bnd.minimum
-				for value > bnd.maximum && overflow > 0 {
-					overflow = T(math.Mod(float64(overflow), float64(r)))
-					if overflow == 0 {
-						value = bnd.minimum
-					} else {
-						value = bnd.minimum + overflow
-					}
+	// Wrap
+	if value > maximum {
+		over := value - maximum
+		breach = Breach(ToString(over))
+
+		var zero T
+		if IsFloat(zero) {
+			r := maximum - minimum
+			for value > maximum && over > 0 {
+				over = T(math.Mod(float64(over), float64(r)))
+				if over == 0 {
+					value = minimum
+				} else {
+					value = minimum + over
 				}
-				bnd.value = value
-				return breach
 			}
-		} else if value < bnd.minimum {
-			underflow := value - bnd.minimum
-			breach = Breach(ToString(underflow))
+			return value, breach, false
+		}
+	} else if value < minimum {
+		underflow := value - minimum
+		breach = Breach(ToString(underflow))
 
-			var zero T
-			if IsFloat(zero) {
-				r := bnd.maximum - bnd.minimum
-				for value < bnd.minimum && underflow < 0 {
-					underflow = T(math.Mod(float64(underflow), float64(r)))
-					if underflow == 0 {
-						value = bnd.maximum
-					} else {
-						value = bnd.maximum + underflow
-					}
+		var zero T
+		if IsFloat(zero) {
+			r := maximum - minimum
+			for value < minimum && underflow < 0 {
+				underflow = T(math.Mod(float64(underflow), float64(r)))
+				if underflow == 0 {
+					value = maximum
+				} else {
+					value = maximum + underflow
 				}
-				bnd.value = value
-				return breach
 			}
+			return value, breach, false
 		}
+	}
+
+	// NOTE: The maximum distance of a primitive type will ALWAYS be a uint64, which is very nice =)
+	distance := uint64(maximum-minimum) + 1
+	// NOTE: This circumvents conversion to a float64 when using Math.Abs()
+	if distance < 0 {
+		distance = -distance
+	}
 
-		// NOTE: The maximum distance of a primitive type will ALWAYS be a uint64, which is very nice =)
-		distance := uint64(bnd.maximum-bnd.minimum) + 1
-		// NOTE: This circumvents conversion to a float64 when using Math.Abs()
-		if distance < 0 {
-			distance = -distance
+	// Check if the distance (or any of the stored values) exceeds an int64 - requiring big.Int
+	needsBig := distance > uint64(math.MaxInt64)
+	if !needsBig {
+		switch any(value).(type) {
+		case uint64, uint, uintptr:
+			needsBig = uint64(value) > uint64(math.MaxInt64) ||
+				uint64(minimum) > uint64(math.MaxInt64) ||
+				uint64(maximum) > uint64(math.MaxInt64)
 		}
+	}
 
-		// Check if the distance (or any of the stored values) exceeds an int64 - requiring big.Int
-		needsBig := distance > uint64(math.MaxInt64)
-		if !needsBig {
-			switch any(value).(type) {
-			case uint64, uint, uintptr:
-				needsBig = uint64(value) > uint64(math.MaxInt64) ||
-					uint64(bnd.minimum) > uint64(math.MaxInt64) ||
-					uint64(bnd.maximum) > uint64(math.MaxInt64)
-			}
+	var diff uint64
+	if needsBig {
+		m := new(big.Int).SetUint64(uint64(minimum))
+		v := new(big.Int).SetUint64(uint64(value))
+		r := new(big.Int).SetUint64(distance)
+
+		d := new(big.Int).Sub(v, m)
+		if d.Sign() < 0 {
+			d = new(big.Int).Add(d, r)
+		}
+
+		diff = d.Uint64()
+	} else {
+		d := int64(value - minimum)
+		if d < 0 {
+			d += int64(distance)
 		}
+		diff = uint64(d)
+	}
 
-		var diff uint64
-		if needsBig {
-			m := new(big.Int).SetUint64(uint64(bnd.minimum))
-			v := new(big.Int).SetUint64(uint64(value))
-			r := new(big.Int).SetUint64(distance)
+	mod := T(diff)
+	if distance > 0 {
+		mod = T(diff % distance)
+	}
+	value = minimum + mod
 
-			d := new(big.Int).Sub(v, m)
-			if d.Sign() < 0 {
-				d = new(big.Int).Add(d, r)
-			}
+	return value, breach, false
+}
 
-			diff = d.Uint64()
-		} else {
-			d := int64(value - bnd.minimum)
-			if d < 0 {
-				d += int64(distance)
-			}
-			diff = uint64(d)
+// reflectPrimitive bounces the value back and forth between the boundaries until it rests within them.  Unfolded,
+// every even span of travel beyond the minimum moves in the original direction, while every odd span is reversed.
+//
+//	𝑝 = 𝑣𝑎𝑙𝑢𝑒 - 𝑚𝑖𝑛   𝑘 = ⌊𝑝 / 𝑠𝑝𝑎𝑛⌋   𝑞 = 𝑝 - 𝑘·𝑠𝑝𝑎𝑛
+//	𝑘 even → 𝑚𝑖𝑛 + 𝑞
+//	𝑘 odd  → 𝑚𝑎𝑥 - 𝑞
+func reflectPrimitive[T Primitive](value, minimum, maximum T) (T, bool) {
+	if minimum == maximum {
+		return minimum, false
+	}
+
+	if IsFloat(value) {
+		span := float64(maximum - minimum)
+		p := float64(value) - float64(minimum)
+		k := math.Floor(p / span)
+		q := T(p - k*span)
+		if math.Mod(k, 2) != 0 {
+			return maximum - q, true
 		}
+		return minimum + q, false
+	}
 
-		mod := T(diff)
-		if distance > 0 {
-			mod = T(diff % distance)
+	toBig := func(v T) *big.Int {
+		if IsSigned(v) {
+			return big.NewInt(int64(v))
 		}
-		value = bnd.minimum + mod
+		return new(big.Int).SetUint64(uint64(v))
 	}
+	return reflectOffset(new(big.Int).Sub(toBig(value), toBig(minimum)), minimum, maximum)
+}
 
-	bnd.value = value
-	return breach
+// reflectOffset reflects an integer offset from the minimum between the boundaries - see reflectPrimitive.
+func reflectOffset[T Primitive](p *big.Int, minimum, maximum T) (T, bool) {
+	if minimum == maximum {
+		return minimum, false
+	}
+
+	span := new(big.Int).SetUint64(uint64(maximum - minimum))
+	k, q := new(big.Int).DivMod(p, span, new(big.Int))
+
+	// NOTE: q always fits within the span, and two's complement keeps the final addition exact even if T(q) overflows
+	if k.Bit(0) == 1 {
+		return maximum - T(q.Uint64()), true
+	}
+	return minimum + T(q.Uint64()), false
 }
 
 // String returns the value as a numeric string.
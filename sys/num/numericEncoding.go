@@ -0,0 +1,286 @@
+package num
+
+import (
+	"core/enum/overflow"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+/**
+Text
+*/
+
+// MarshalText encodes the value followed by its closed boundaries and any non-default overflow behavior, satisfying
+// encoding.TextMarshaler -
+//
+//	5 [0, 7]
+//	5 [0, 7] clamp
+//	5 [0, 7] reflect
+//	5 unbounded
+//
+// NOTE: Natural and Realized values are encoded using their base₁₀ string form, so no precision is lost.
+func (bnd Numeric[T]) MarshalText() ([]byte, error) {
+	bnd.sanityCheck()
+
+	value, err := textOf(bnd.value)
+	if err != nil {
+		return nil, err
+	}
+	if bnd.unbounded {
+		return []byte(value + " unbounded"), nil
+	}
+
+	minimum, err := textOf(bnd.minimum)
+	if err != nil {
+		return nil, err
+	}
+	maximum, err := textOf(bnd.maximum)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("%s [%s, %s]", value, minimum, maximum))
+	if bnd.Clamp {
+		builder.WriteString(" clamp")
+	}
+	if bnd.Overflow != overflow.Unset {
+		builder.WriteString(" " + strings.ToLower(bnd.Overflow.String()))
+	}
+	return []byte(builder.String()), nil
+}
+
+// UnmarshalText decodes the output of MarshalText, satisfying encoding.TextUnmarshaler.
+//
+// NOTE: The decoded value is set through the decoded boundaries, so out of bounds text is bounded accordingly.
+func (bnd *Numeric[T]) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+
+	if value, ok := strings.CutSuffix(s, " unbounded"); ok {
+		v, err := parseText[T](value)
+		if err != nil {
+			return err
+		}
+		var zero T
+		bnd.restore(v, zero, zero, true, false, overflow.Unset)
+		return nil
+	}
+
+	opening := strings.LastIndex(s, "[")
+	closing := strings.LastIndex(s, "]")
+	if opening < 0 || closing < opening {
+		return fmt.Errorf("numeric text must hold its boundaries in brackets: %q", s)
+	}
+
+	bounds := strings.Split(s[opening+1:closing], ",")
+	if len(bounds) != 2 {
+		return fmt.Errorf("numeric text must hold exactly two boundaries: %q", s)
+	}
+
+	value, err := parseText[T](strings.TrimSpace(s[:opening]))
+	if err != nil {
+		return err
+	}
+	minimum, err := parseText[T](strings.TrimSpace(bounds[0]))
+	if err != nil {
+		return err
+	}
+	maximum, err := parseText[T](strings.TrimSpace(bounds[1]))
+	if err != nil {
+		return err
+	}
+
+	clamp := false
+	policy := overflow.Unset
+	for _, flag := range strings.Fields(s[closing+1:]) {
+		if flag == "clamp" {
+			clamp = true
+			continue
+		}
+		if policy, err = overflow.Parse(flag); err != nil {
+			return err
+		}
+	}
+
+	bnd.restore(value, minimum, maximum, false, clamp, policy)
+	return nil
+}
+
+/**
+JSON
+*/
+
+type numericJSON struct {
+	Value     json.RawMessage `json:"value"`
+	Minimum   json.RawMessage `json:"minimum,omitempty"`
+	Maximum   json.RawMessage `json:"maximum,omitempty"`
+	Unbounded bool            `json:"unbounded"`
+	Clamp     bool            `json:"clamp"`
+	Overflow  string          `json:"overflow"`
+}
+
+// MarshalJSON encodes the value, its boundaries, and its overflow behavior as a JSON object.
+//
+//	{"value":5,"minimum":0,"maximum":7,"unbounded":false,"clamp":true,"overflow":"Unset"}
+//	{"value":5,"unbounded":true,"clamp":false,"overflow":"Unset"}
+//
+// NOTE: Natural, Realized, and complex values are encoded as JSON strings, so no precision is lost - as are NaN
+// and ±Inf, which JSON numbers cannot represent.
+func (bnd Numeric[T]) MarshalJSON() ([]byte, error) {
+	bnd.sanityCheck()
+
+	var out numericJSON
+	var err error
+	if out.Value, err = jsonOf(bnd.value); err != nil {
+		return nil, err
+	}
+	if !bnd.unbounded {
+		if out.Minimum, err = jsonOf(bnd.minimum); err != nil {
+			return nil, err
+		}
+		if out.Maximum, err = jsonOf(bnd.maximum); err != nil {
+			return nil, err
+		}
+	}
+	out.Unbounded = bnd.unbounded
+	out.Clamp = bnd.Clamp
+	out.Overflow = bnd.Overflow.String()
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes the output of MarshalJSON.  The boundaries may be omitted from unbounded values.
+//
+// NOTE: The decoded value is set through the decoded boundaries, so an out of bounds value is bounded accordingly.
+func (bnd *Numeric[T]) UnmarshalJSON(data []byte) error {
+	var raw numericJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	value, err := parseJSON[T](raw.Value)
+	if err != nil {
+		return err
+	}
+
+	policy := overflow.Unset
+	if len(raw.Overflow) > 0 {
+		if policy, err = overflow.Parse(raw.Overflow); err != nil {
+			return err
+		}
+	}
+
+	var minimum, maximum T
+	if len(raw.Minimum) > 0 || len(raw.Maximum) > 0 || !raw.Unbounded {
+		if minimum, err = parseJSON[T](raw.Minimum); err != nil {
+			return fmt.Errorf("invalid minimum: %w", err)
+		}
+		if maximum, err = parseJSON[T](raw.Maximum); err != nil {
+			return fmt.Errorf("invalid maximum: %w", err)
+		}
+	}
+	bnd.restore(value, minimum, maximum, raw.Unbounded, raw.Clamp, policy)
+	return nil
+}
+
+/**
+Internals
+*/
+
+// restore reinstates a decoded state, then bounds primitive values through Set - so subscribers are notified, and
+// out of bounds input follows the decoded overflow policy.
+//
+// NOTE: Advanced values are restored exactly as decoded, as they cannot yet be bounded.
+func (bnd *Numeric[T]) restore(value, minimum, maximum T, unbounded, clamp bool, policy overflow.Policy) {
+	bnd.sanityCheck()
+
+	primitive := IsPrimitive(value) && !IsComplex(value)
+	if primitive && !unbounded && Compare(minimum, maximum) == 1 {
+		minimum, maximum = maximum, minimum
+	}
+	bnd.minimum = minimum
+	bnd.maximum = maximum
+	bnd.unbounded = unbounded
+	bnd.Clamp = clamp
+	bnd.Overflow = policy
+
+	if primitive {
+		bnd.Set(value)
+		return
+	}
+	bnd.value = value
+}
+
+// textOf returns the lossless string form of the provided value.
+func textOf[T Advanced](value T) (string, error) {
+	switch typed := any(&value).(type) {
+	case *Natural:
+		return typed.String(), nil
+	case *Realized:
+		if typed.Base() != 10 {
+			r := FromRat(ToRat(typed))
+			return r.String(), nil
+		}
+		return typed.String(), nil
+	default:
+		// NOTE: The %v verb yields the shortest representation which round-trips a float
+		return fmt.Sprint(value), nil
+	}
+}
+
+// parseText parses the lossless string form of a value.
+func parseText[T Advanced](s string) (T, error) {
+	var out T
+	if len(s) == 0 {
+		return out, fmt.Errorf("cannot parse an empty %T", out)
+	}
+
+	switch typed := any(&out).(type) {
+	case *Natural:
+		*typed = ParseNatural(s)
+	case *Realized:
+		*typed = ParseRealized(s)
+	default:
+		if _, err := fmt.Sscan(s, &out); err != nil {
+			return out, fmt.Errorf("cannot parse %q as %T: %w", s, out, err)
+		}
+	}
+	return out, nil
+}
+
+// jsonOf encodes finite primitive values as JSON numbers, and everything else as its lossless JSON string.
+func jsonOf[T Advanced](value T) (json.RawMessage, error) {
+	switch any(&value).(type) {
+	case *Natural, *Realized, *complex64, *complex128:
+		s, err := textOf(value)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(s)
+	default:
+		if inf, _ := IsInf(value); inf || IsNaN(value) {
+			return json.Marshal(fmt.Sprint(value))
+		}
+		return json.Marshal(value)
+	}
+}
+
+// parseJSON decodes the output of jsonOf.
+func parseJSON[T Advanced](raw json.RawMessage) (T, error) {
+	var out T
+	switch any(&out).(type) {
+	case *Natural, *Realized, *complex64, *complex128:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return out, err
+		}
+		return parseText[T](s)
+	default:
+		var s string
+		if json.Unmarshal(raw, &s) == nil {
+			return parseText[T](s)
+		}
+		err := json.Unmarshal(raw, &out)
+		return out, err
+	}
+}
@@ -0,0 +1,299 @@
+// Package numtest provides generators of random num operands and reusable invariant checks for property-based testing.
+//
+// Every generator draws from the global entropy source, so scoping a seeded source makes a failing run reproducible
+// without leaking the seed into other tests -
+//
+//	entropy.Scoped(entropy.NewSeeded(42), func() {
+//		for i := 0; i < 100; i++ {
+//			r := numtest.Realized(8)
+//			numtest.ParsePrintRoundTrip(t, &r, num.FormatPlain)
+//		}
+//	})
+//
+// See entropy.Scoped and entropy.NewSeeded
+package numtest
+
+import (
+	"bytes"
+	"core/sys/entropy"
+	"core/sys/num"
+	"core/sys/num/internal"
+	"math"
+	"math/big"
+	"strings"
+)
+
+/**
+Naturals and Measurements
+*/
+
+// Natural returns a random natural number of between one and the provided maximum number of digits in the provided
+// base, or base₁₀ if omitted.
+//
+// NOTE: This will panic if maxDigits is zero.
+func Natural(maxDigits uint, base ...uint16) num.Natural {
+	return num.RandomNatural(between(1, maxDigits), base...)
+}
+
+// Measurement returns a random measurement of between one and the provided maximum number of bits.
+//
+// NOTE: Leading zero bits are kept, as a measurement's width is significant.
+//
+// NOTE: This will panic if maxBits is zero.
+func Measurement(maxBits uint) num.Measurement {
+	width := between(1, maxBits)
+
+	builder := strings.Builder{}
+	for i := uint(0); i < width; i++ {
+		builder.WriteByte(byte('0' + entropy.IntN(2)))
+	}
+	return num.NewMeasurementOfBinaryString(builder.String())
+}
+
+/**
+Realized Numbers
+*/
+
+// Realized returns a random static realized number which is equally likely to be terminating, periodic, or irrational -
+// each holding up to the provided number of digits per component, in the provided base or base₁₀ if omitted.
+//
+// See Terminating, Periodic, and Irrational
+func Realized(maxDigits uint, base ...uint16) num.Realized {
+	switch entropy.IntN(3) {
+	case 0:
+		return Terminating(maxDigits, base...)
+	case 1:
+		return Periodic(maxDigits, base...)
+	default:
+		return Irrational(maxDigits, base...)
+	}
+}
+
+// Terminating returns a random signed realized number without a periodic component.
+//
+//	"-1204.0031"
+//
+// NOTE: This will panic if maxDigits is zero.
+func Terminating(maxDigits uint, base ...uint16) num.Realized {
+	b := num.PanicIfInvalidBase(base...)
+	return compose(false, randomDigits(between(1, maxDigits), b), randomDigits(between(0, maxDigits), b), nil, b)
+}
+
+// Periodic returns a random signed realized number with a periodic component.
+//
+//	"12.04‾31"
+//
+// NOTE: The periodic component never repeats only zeros or only the base's largest digit, as ‾0 and ‾9 would be
+// better expressed as terminating values - but any other repeated digit, such as 0.‾3, is fair game.  As every
+// single digit period is one of the two in base₂, its periods are always at least two digits wide.
+//
+// NOTE: This will panic if maxDigits is zero.
+func Periodic(maxDigits uint, base ...uint16) num.Realized {
+	b := num.PanicIfInvalidBase(base...)
+
+	shortest := uint(1)
+	if b == 2 {
+		shortest = 2
+	}
+	period := randomDigits(between(shortest, max(maxDigits, shortest)), b)
+	for uniform(period, 0) || uniform(period, byte(b-1)) {
+		period = randomDigits(uint(len(period)), b)
+	}
+	return compose(false, randomDigits(between(1, maxDigits), b), randomDigits(between(0, maxDigits), b), period, b)
+}
+
+// Irrational returns a random signed irrational realized number, calculated to up to the provided number of fractional
+// placeholders.
+//
+//	"~-3.14159"
+//
+// NOTE: This will panic if maxDigits is zero.
+func Irrational(maxDigits uint, base ...uint16) num.Realized {
+	b := num.PanicIfInvalidBase(base...)
+	return compose(true, randomDigits(between(1, maxDigits), b), randomDigits(between(1, maxDigits), b), nil, b)
+}
+
+/**
+Operands
+*/
+
+// Primitive returns a random value of a random primitive Go numeric type.
+//
+// NOTE: Floating point values are finite, and are bounded in the closed interval [-2⁶³, 2⁶³].
+func Primitive() any {
+	u := entropy.Uint64()
+	f := (entropy.Float64()*2 - 1) * math.Pow(2, float64(entropy.IntN(64)))
+
+	switch entropy.IntN(13) {
+	case 0:
+		return int(u)
+	case 1:
+		return int8(u)
+	case 2:
+		return int16(u)
+	case 3:
+		return int32(u)
+	case 4:
+		return int64(u)
+	case 5:
+		return uint(u)
+	case 6:
+		return uint8(u)
+	case 7:
+		return uint16(u)
+	case 8:
+		return uint32(u)
+	case 9:
+		return u
+	case 10:
+		return uintptr(u)
+	case 11:
+		return float32(f)
+	default:
+		return f
+	}
+}
+
+// NamedInteger is a named signed integer type, as found in user code, which FilterOperands resolves by its kind.
+type NamedInteger int32
+
+// NamedUnsigned is a named unsigned integer type, as found in user code, which FilterOperands resolves by its kind.
+type NamedUnsigned uint16
+
+// NamedFloat is a named floating point type, as found in user code, which FilterOperands resolves by its kind.
+type NamedFloat float64
+
+// Named returns a random value of a random named numeric type - see NamedInteger, NamedUnsigned, and NamedFloat.
+func Named() any {
+	switch entropy.IntN(3) {
+	case 0:
+		return NamedInteger(entropy.Uint64())
+	case 1:
+		return NamedUnsigned(entropy.Uint64())
+	default:
+		return NamedFloat(float32((entropy.Float64()*2 - 1) * float64(entropy.IntN(1<<16))))
+	}
+}
+
+// Big returns a random signed *big.Int of up to the provided number of digits, or a random *big.Float.
+//
+// NOTE: The *big.Float values are limited to float32 precision, so their decimal form is always exact.
+func Big(maxDigits uint) any {
+	if entropy.IntN(2) == 0 {
+		i := num.ToRat(Natural(maxDigits)).Num()
+		if entropy.IntN(2) == 1 {
+			i.Neg(i)
+		}
+		return i
+	}
+	return big.NewFloat(float64(float32((entropy.Float64()*2 - 1) * float64(entropy.IntN(1<<16)))))
+}
+
+// Operand returns a random FilterOperands compatible operand - a primitive, named numeric type, big number, natural,
+// measurement, realized number, or numeric string - wrapped in a random input shape.
+//
+// See Shapes, Primitive, Named, and Big
+func Operand(maxDigits uint) any {
+	var value any
+	switch entropy.IntN(7) {
+	case 0:
+		value = Primitive()
+	case 1:
+		value = Named()
+	case 2:
+		value = Big(maxDigits)
+	case 3:
+		value = Natural(maxDigits)
+	case 4:
+		value = Measurement(maxDigits * 4)
+	case 5:
+		r := Realized(maxDigits)
+		value = r.PrintFormatted(num.FormatPlain, -1)
+	default:
+		value = Terminating(maxDigits)
+	}
+
+	shapes := Shapes(value)
+	return shapes[entropy.IntN(len(shapes))]
+}
+
+// Shapes returns the provided value in every input shape which FilterOperands resolves back to the value itself -
+//
+//	T, *T, **T,
+//	func() any, func() T, func() func() T,
+//	func(uint) T, func(*uint) T, func(...uint) T, and func(...*uint) T
+func Shapes[T any](value T) []any {
+	pointer := &value
+	return []any{
+		value,
+		pointer,
+		&pointer,
+		func() any { return value },
+		func() T { return value },
+		func() func() T { return func() T { return value } },
+		func(uint) T { return value },
+		func(*uint) T { return value },
+		func(...uint) T { return value },
+		func(...*uint) T { return value },
+	}
+}
+
+/**
+Helpers
+*/
+
+// between returns a random value in the closed interval [a, b].
+func between(a, b uint) uint {
+	if b < a {
+		panic("cannot generate a value from an empty range")
+	}
+	return a + uint(entropy.IntN(int(b-a)+1))
+}
+
+// uniform returns whether every one of the provided digits is the provided digit.
+func uniform(digits []byte, digit byte) bool {
+	return bytes.Count(digits, []byte{digit}) == len(digits)
+}
+
+// randomDigits returns the requested number of random digits in the provided base.
+func randomDigits(count uint, base uint16) []byte {
+	out := make([]byte, count)
+	for i := range out {
+		out[i] = byte(entropy.IntN(int(base)))
+	}
+	return out
+}
+
+// compose creates a realized number of a random sign from the provided digits, by printing and then parsing it.
+func compose(irrational bool, whole, fractional, periodic []byte, base uint16) num.Realized {
+	var components []string
+	if irrational {
+		components = append(components, "~")
+	}
+	if entropy.IntN(2) == 1 {
+		components = append(components, "-")
+	}
+	components = append(components, printed(whole, base)...)
+	if len(fractional) > 0 || len(periodic) > 0 {
+		components = append(components, ".")
+		components = append(components, printed(fractional, base)...)
+		if len(periodic) > 0 {
+			components = append(components, "‾")
+			components = append(components, printed(periodic, base)...)
+		}
+	}
+
+	if base > 16 {
+		return num.FormatPlain.Parse(strings.Join(components, " "), base)
+	}
+	return num.FormatPlain.Parse(strings.Join(components, ""), base)
+}
+
+// printed prints each of the provided digits as a placeholder.
+func printed(digits []byte, base uint16) []string {
+	out := make([]string, len(digits))
+	for i, d := range digits {
+		out[i] = internal.PrintDigit(d, base)
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package numtest
+
+import (
+	"bytes"
+	"core/sys/num"
+	"testing"
+)
+
+/**
+Round Trips
+*/
+
+// BaseRoundTrip checks that converting the natural's digits from the provided source base into every target base, and
+// then back again, reproduces the original digits.
+func BaseRoundTrip(t testing.TB, n num.Natural, source uint16, targets ...uint16) {
+	t.Helper()
+
+	digits := n.Digits(source)
+	for _, target := range targets {
+		converted, _ := num.Base.DigitsToDigits(digits, source, target)
+		back, _ := num.Base.DigitsToDigits(converted, target, source)
+		if !bytes.Equal(trimDigits(back), trimDigits(digits)) {
+			t.Errorf("base%d → base%d → base%d round trip of %v yielded %v", source, target, source, digits, back)
+		}
+	}
+}
+
+// ParsePrintRoundTrip checks that printing the realized number in the provided format, and then parsing the result,
+// reproduces both the same printed output and the same value to its calculated precision.
+func ParsePrintRoundTrip(t testing.TB, r *num.Realized, format num.NumberFormat) {
+	t.Helper()
+
+	base := r.Base()
+	printed := r.PrintFormatted(format, -1, base)
+	parsed := format.Parse(printed, base)
+	if reprinted := parsed.PrintFormatted(format, -1, base); reprinted != printed {
+		t.Errorf("printed %q, but parsing it back printed %q", printed, reprinted)
+	}
+	if num.ToRat(r).Cmp(num.ToRat(&parsed)) != 0 {
+		t.Errorf("parsing %q yielded %s rather than %s", printed, num.ToRat(&parsed).RatString(), num.ToRat(r).RatString())
+	}
+}
+
+/**
+Algebraic Laws
+*/
+
+// Equal reports whether the provided operands hold exactly the same value, regardless of their type.
+//
+// See num.ToRat
+func Equal(a, b any) bool {
+	return num.ToRat(a).Cmp(num.ToRat(b)) == 0
+}
+
+// Commutative checks that the provided operation yields equal results in either order, for every pair of distinct
+// positions within the provided operands.
+//
+//	𝑎 ∘ 𝑏 = 𝑏 ∘ 𝑎
+//
+// NOTE: If no equality is provided, Equal is used.
+func Commutative(t testing.TB, operation func(a, b any) any, equal func(a, b any) bool, operands ...any) {
+	t.Helper()
+	if equal == nil {
+		equal = Equal
+	}
+
+	for i, a := range operands {
+		for _, b := range operands[i+1:] {
+			if ab, ba := operation(a, b), operation(b, a); !equal(ab, ba) {
+				t.Errorf("%v ∘ %v = %v, but %v ∘ %v = %v", a, b, ab, b, a, ba)
+			}
+		}
+	}
+}
+
+// Associative checks that the provided operation yields equal results regardless of grouping, for every ordered
+// triple of the provided operands - repetitions included.
+//
+//	(𝑎 ∘ 𝑏) ∘ 𝑐 = 𝑎 ∘ (𝑏 ∘ 𝑐)
+//
+// NOTE: If no equality is provided, Equal is used.
+func Associative(t testing.TB, operation func(a, b any) any, equal func(a, b any) bool, operands ...any) {
+	t.Helper()
+	if equal == nil {
+		equal = Equal
+	}
+
+	for _, a := range operands {
+		for _, b := range operands {
+			for _, c := range operands {
+				left := operation(operation(a, b), c)
+				right := operation(a, operation(b, c))
+				if !equal(left, right) {
+					t.Errorf("(%v ∘ %v) ∘ %v = %v, but %v ∘ (%v ∘ %v) = %v", a, b, c, left, a, b, c, right)
+				}
+			}
+		}
+	}
+}
+
+// trimDigits removes any leading zero digits, leaving at least one.
+func trimDigits(digits []byte) []byte {
+	for len(digits) > 1 && digits[0] == 0 {
+		digits = digits[1:]
+	}
+	return digits
+}
@@ -0,0 +1,117 @@
+package test
+
+import (
+	"core/sys/entropy"
+	"core/sys/num"
+	"core/sys/num/numtest"
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func Test_BaseRoundTrip(t *testing.T) {
+	entropy.Scoped(entropy.NewSeeded(42), func() {
+		for i := 0; i < 50; i++ {
+			numtest.BaseRoundTrip(t, numtest.Natural(12), 10, 2, 16, 17, 256)
+		}
+	})
+}
+
+func Test_ParsePrintRoundTrip(t *testing.T) {
+	formats := []num.NumberFormat{num.FormatPlain, num.FormatWestern, num.FormatIndian, num.FormatSI}
+	entropy.Scoped(entropy.NewSeeded(42), func() {
+		for _, base := range []uint16{2, 10, 16, 17} {
+			for i := 0; i < 25; i++ {
+				r := numtest.Realized(8, base)
+				numtest.ParsePrintRoundTrip(t, &r, formats[i%len(formats)])
+			}
+		}
+	})
+}
+
+func Test_Periodic(t *testing.T) {
+	entropy.Scoped(entropy.NewSeeded(42), func() {
+		for _, base := range []uint16{2, 3, 10} {
+			single := false
+			for i := 0; i < 50; i++ {
+				r := numtest.Periodic(4, base)
+				_, _, periodic := r.Digits()
+				if len(periodic) == 0 {
+					t.Fatalf("expected a periodic component in base%d", base)
+				}
+
+				zeros, largest := true, true
+				for _, d := range periodic {
+					zeros = zeros && d == 0
+					largest = largest && d == byte(base-1)
+				}
+				if zeros || largest {
+					t.Errorf("expected base%d to never repeat only %d, got ‾%v", base, periodic[0], periodic)
+				}
+				single = single || len(periodic) == 1
+			}
+			if base > 2 && !single {
+				t.Errorf("expected base%d to produce single digit periods", base)
+			}
+		}
+	})
+}
+
+func Test_Shapes(t *testing.T) {
+	value := numtest.Natural(6)
+	for _, shape := range numtest.Shapes(value) {
+		if filtered := num.FilterOperands(10, shape)[0]; !numtest.Equal(filtered, value) {
+			t.Errorf("expected %T to filter to %v, got %v", shape, value, filtered)
+		}
+	}
+}
+
+func Test_Shapes_BigAndNamed(t *testing.T) {
+	seen := make(map[string]bool)
+	entropy.Scoped(entropy.NewSeeded(42), func() {
+		for i := 0; i < 50; i++ {
+			for _, value := range []any{numtest.Big(12), numtest.Named()} {
+				seen[fmt.Sprintf("%T", value)] = true
+				for _, shape := range numtest.Shapes(value) {
+					if filtered := num.FilterOperands(10, shape)[0]; !numtest.Equal(filtered, value) {
+						t.Errorf("expected %T to filter to %v, got %v", shape, value, filtered)
+					}
+				}
+			}
+		}
+	})
+
+	for _, expected := range []string{"*big.Int", "*big.Float", "numtest.NamedInteger", "numtest.NamedUnsigned", "numtest.NamedFloat"} {
+		if !seen[expected] {
+			t.Errorf("expected a %s to be generated", expected)
+		}
+	}
+}
+
+func Test_Operand(t *testing.T) {
+	entropy.Scoped(entropy.NewSeeded(42), func() {
+		for i := 0; i < 200; i++ {
+			num.FilterOperands(10, numtest.Operand(6))
+		}
+	})
+}
+
+func Test_AlgebraicLaws(t *testing.T) {
+	operations := []struct {
+		name      string
+		operation func(a, b any) any
+	}{
+		{"add", func(a, b any) any { return new(big.Rat).Add(num.ToRat(a), num.ToRat(b)) }},
+		{"multiply", func(a, b any) any { return new(big.Rat).Mul(num.ToRat(a), num.ToRat(b)) }},
+	}
+
+	for _, o := range operations {
+		t.Run(o.name, func(t *testing.T) {
+			entropy.Scoped(entropy.NewSeeded(42), func() {
+				operands := []any{numtest.Natural(6), numtest.Primitive(), numtest.Measurement(16), numtest.Terminating(4), numtest.Big(6)}
+				numtest.Commutative(t, o.operation, nil, operands...)
+				numtest.Associative(t, o.operation, nil, operands...)
+			})
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package num
+
+import (
+	"cmp"
+	"core/enum/change"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// An Event describes a change to an observed Numeric.  Previous holds the value before a change.Value event, while
+// Breach holds the amount a change.Breach event overflowed or underflowed the boundaries.
+//
+// See Numeric.Subscribe, Numeric.OnChange, Numeric.OnBreach, Numeric.OnBoundaries, and Numeric.Events
+type Event[T Advanced] struct {
+	Kind     change.Kind
+	Previous T
+	Value    T
+	Minimum  T
+	Maximum  T
+	Breach   Breach
+}
+
+// A Subscription is a cancellable registration of interest in a Numeric's events.
+//
+// Every subscription latches whenever one of its events fire, making it directly usable as a potential - so a
+// neuron may simply test Subscription.Potential rather than polling the value itself.  See see.ActionPotentials
+type Subscription struct {
+	gate      sync.Mutex
+	cancelled bool
+	latched   bool
+	detach    func()
+	closer    func()
+}
+
+// Cancel stops any further events from reaching this subscription.  It's safe to call more than once, and from
+// within the subscription's own action.
+func (s *Subscription) Cancel() {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+
+	if s.cancelled {
+		return
+	}
+	s.cancelled = true
+	s.detach()
+	if s.closer != nil {
+		s.closer()
+	}
+}
+
+// Cancelled returns whether this subscription has been cancelled.
+func (s *Subscription) Cancelled() bool {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+	return s.cancelled
+}
+
+// Potential returns a potential function which yields true once for every time the subscription's events have fired
+// since it was last tested - see.ActionPotentials
+func (s *Subscription) Potential() func() bool {
+	return func() bool {
+		s.gate.Lock()
+		defer s.gate.Unlock()
+
+		fired := s.latched
+		s.latched = false
+		return fired
+	}
+}
+
+// Subscribe registers the provided action to be called with every event of the provided kinds, or every event if
+// none are provided.  The action may be nil if you only intend to test the subscription's Potential.
+//
+// NOTE: Actions are called synchronously on the goroutine which changed the Numeric, after the change has been made.
+//
+// NOTE: Copies of a Numeric share its subscriptions - including the snapshots held by a Concurrent.  Every constructor
+// creates the observers up front, but a zero value Numeric only creates them upon its first subscription - so any
+// copy of a zero value made before then won't observe later subscriptions.
+//
+// See Numeric.Subscribe, Numeric.OnChange, Numeric.OnBreach, Numeric.OnBoundaries, and Numeric.Events
+func (bnd *Numeric[T]) Subscribe(action func(Event[T]), kinds ...change.Kind) *Subscription {
+	return bnd.observing().add(action, nil, kinds...)
+}
+
+// OnChange registers the provided action to be called whenever the value changes.
+//
+// See Numeric.Subscribe, Numeric.OnChange, Numeric.OnBreach, Numeric.OnBoundaries, and Numeric.Events
+func (bnd *Numeric[T]) OnChange(action func(Event[T])) *Subscription {
+	return bnd.Subscribe(action, change.Value)
+}
+
+// OnBreach registers the provided action to be called whenever an operation breaches the boundaries.
+//
+// See Numeric.Subscribe, Numeric.OnChange, Numeric.OnBreach, Numeric.OnBoundaries, and Numeric.Events
+func (bnd *Numeric[T]) OnBreach(action func(Event[T])) *Subscription {
+	return bnd.Subscribe(action, change.Breach)
+}
+
+// OnBoundaries registers the provided action to be called whenever the boundaries are moved.
+//
+// See Numeric.Subscribe, Numeric.OnChange, Numeric.OnBreach, Numeric.OnBoundaries, and Numeric.Events
+func (bnd *Numeric[T]) OnBoundaries(action func(Event[T])) *Subscription {
+	return bnd.Subscribe(action, change.Boundaries)
+}
+
+// Events returns a channel which receives every event of the provided kinds, or every event if none are provided.
+// Cancelling the subscription closes the channel.
+//
+// NOTE: Events are dropped if the channel's buffer is full, so a slow reader can never stall the Numeric.
+//
+// See Numeric.Subscribe, Numeric.OnChange, Numeric.OnBreach, Numeric.OnBoundaries, and Numeric.Events
+func (bnd *Numeric[T]) Events(buffer int, kinds ...change.Kind) (<-chan Event[T], *Subscription) {
+	events := make(chan Event[T], buffer)
+	send := func(e Event[T]) {
+		select {
+		case events <- e:
+		default:
+		}
+	}
+	return events, bnd.observing().add(send, func() { close(events) }, kinds...)
+}
+
+// observing returns the Numeric's observers, creating them if necessary.
+func (bnd *Numeric[T]) observing() *observers[T] {
+	if bnd.observers == nil {
+		bnd.observers = newObservers[T]()
+	}
+	return bnd.observers
+}
+
+// emit notifies any subscribers that the value has been set, and whether it breached the boundaries.
+func (bnd *Numeric[T]) emit(previous T, breach Breach) {
+	if bnd.observers == nil || bnd.observers.empty() {
+		return
+	}
+	if !unchanged(previous, bnd.value) {
+		bnd.observers.publish(Event[T]{Kind: change.Value, Previous: previous, Value: bnd.value, Minimum: bnd.minimum, Maximum: bnd.maximum})
+	}
+	if breach != "" {
+		bnd.observers.publish(Event[T]{Kind: change.Breach, Previous: previous, Value: bnd.value, Minimum: bnd.minimum, Maximum: bnd.maximum, Breach: breach})
+	}
+}
+
+// emitBoundaries notifies any subscribers if the boundaries have moved.
+func (bnd *Numeric[T]) emitBoundaries(minimum, maximum T) {
+	if bnd.observers == nil || bnd.observers.empty() {
+		return
+	}
+	if !unchanged(minimum, bnd.minimum) || !unchanged(maximum, bnd.maximum) {
+		bnd.observers.publish(Event[T]{Kind: change.Boundaries, Previous: bnd.value, Value: bnd.value, Minimum: bnd.minimum, Maximum: bnd.maximum})
+	}
+}
+
+// unchanged returns whether the two values are equal - treating NaN as unchanged from NaN, rather than panicking
+// as Compare would.
+func unchanged[T Advanced](a, b T) bool {
+	if IsNaN(a) || IsNaN(b) {
+		return IsNaN(a) && IsNaN(b)
+	}
+	return Compare(a, b) == 0
+}
+
+type subscriber[T Advanced] struct {
+	id           uint64
+	subscription *Subscription
+	action       func(Event[T])
+	kinds        []change.Kind
+}
+
+type observers[T Advanced] struct {
+	gate        sync.Mutex
+	next        uint64
+	subscribers map[uint64]*subscriber[T]
+	count       atomic.Int64
+}
+
+// newObservers creates an empty set of observers, to be shared by every copy of a Numeric.
+func newObservers[T Advanced]() *observers[T] {
+	return &observers[T]{subscribers: make(map[uint64]*subscriber[T])}
+}
+
+func (o *observers[T]) add(action func(Event[T]), closer func(), kinds ...change.Kind) *Subscription {
+	o.gate.Lock()
+	defer o.gate.Unlock()
+
+	id := o.next
+	o.next++
+
+	s := &Subscription{closer: closer}
+	s.detach = func() {
+		o.gate.Lock()
+		defer o.gate.Unlock()
+		delete(o.subscribers, id)
+		o.count.Add(-1)
+	}
+	o.subscribers[id] = &subscriber[T]{id: id, subscription: s, action: action, kinds: kinds}
+	o.count.Add(1)
+	return s
+}
+
+// empty returns whether there are no subscribers, letting unobserved changes skip comparing their values entirely.
+func (o *observers[T]) empty() bool {
+	return o.count.Load() == 0
+}
+
+// announce compares a committed state against its predecessor and publishes the differences - allowing a Concurrent
+// to silently retry operations and only notify subscribers of the change which actually took place.
+func (o *observers[T]) announce(previous, current *Numeric[T], breach Breach) {
+	if o.empty() {
+		return
+	}
+	if !unchanged(previous.minimum, current.minimum) || !unchanged(previous.maximum, current.maximum) {
+		o.publish(Event[T]{Kind: change.Boundaries, Previous: previous.value, Value: current.value, Minimum: current.minimum, Maximum: current.maximum})
+	}
+	if !unchanged(previous.value, current.value) {
+		o.publish(Event[T]{Kind: change.Value, Previous: previous.value, Value: current.value, Minimum: current.minimum, Maximum: current.maximum})
+	}
+	if breach != "" {
+		o.publish(Event[T]{Kind: change.Breach, Previous: previous.value, Value: current.value, Minimum: current.minimum, Maximum: current.maximum, Breach: breach})
+	}
+}
+
+// publish delivers the event to every interested subscriber, outside of the observers' lock - so actions may freely
+// subscribe, cancel, or even mutate the Numeric they observe.
+func (o *observers[T]) publish(e Event[T]) {
+	o.gate.Lock()
+	interested := make([]*subscriber[T], 0, len(o.subscribers))
+	for _, s := range o.subscribers {
+		if len(s.kinds) == 0 || slices.Contains(s.kinds, e.Kind) {
+			interested = append(interested, s)
+		}
+	}
+	o.gate.Unlock()
+	slices.SortFunc(interested, func(a, b *subscriber[T]) int { return cmp.Compare(a.id, b.id) })
+
+	for _, s := range interested {
+		s.subscription.gate.Lock()
+		if s.subscription.cancelled {
+			s.subscription.gate.Unlock()
+			continue
+		}
+		s.subscription.latched = true
+		if s.subscription.closer != nil {
+			// Channel sends happen under the subscription's lock, so Cancel can never close a channel mid-send
+			s.action(e)
+			s.subscription.gate.Unlock()
+			continue
+		}
+		s.subscription.gate.Unlock()
+
+		if s.action != nil {
+			s.action(e)
+		}
+	}
+}
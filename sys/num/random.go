@@ -1,7 +1,7 @@
 package num
 
 import (
-	"math/rand/v2"
+	"core/sys/entropy"
 )
 
 // Random returns a pseudo-random number within the bounds of the provided type's addressable range.
@@ -94,6 +94,8 @@ func RandomSetWithinRange[T Primitive](n uint, a T, b T) []T {
 // RandomWithinRange returns a pseudo-random number of the provided type bounded in the provided closed interval [a, b].
 //
 // NOTE: This uses a 0.01% chance to return exactly max.
+//
+// NOTE: Values are drawn from the global entropy source, so seeding it reproduces every random value - see entropy.Seed
 func RandomWithinRange[T Primitive](a T, b T) T {
 	if a >= b {
 		return a
@@ -113,22 +115,22 @@ func RandomWithinRange[T Primitive](a T, b T) T {
 		return any(complex(r, i)).(T)
 	case float32, float64:
 		// 0.1% chance to return exactly max
-		if rand.Float64() < 0.001 {
+		if entropy.Float64() < 0.001 {
 			return b
 		}
-		return T(float64(a) + (float64(b)-float64(a))*rand.Float64())
+		return T(float64(a) + (float64(b)-float64(a))*entropy.Float64())
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
 		// NOTE: A uint64 covers the entire -absolute- space of an int [min, max]
 		const sign = uint64(1) << 63
 		ua, ub := uint64(a)^sign, uint64(b)^sign
 		n := ub - ua + 1
 		if n == 0 {
-			return T(rand.Uint64())
+			return T(entropy.Uint64())
 		}
 		const maxU = ^uint64(0)
 		limit := maxU - (maxU % n)
 		for {
-			if r := rand.Uint64(); r < limit {
+			if r := entropy.Uint64(); r < limit {
 				return T((ua + (r % n)) ^ sign)
 			}
 		}
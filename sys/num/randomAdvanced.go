@@ -0,0 +1,203 @@
+package num
+
+import (
+	"core/sys/atlas"
+	"core/sys/entropy"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+// RandomNatural returns a uniformly random natural number of exactly the provided number of digits in the provided
+// base, or base₁₀ if omitted - meaning its leading digit is never zero, unless a single digit is requested.
+//
+//	RandomNatural(3)     // [100, 999]
+//	RandomNatural(8, 2)  // [10000000, 11111111]₂
+//
+// NOTE: This will panic if zero digits are requested.
+func RandomNatural(digits uint, base ...uint16) Natural {
+	if digits == 0 {
+		panic("cannot generate a random natural of zero digits")
+	}
+	b := big.NewInt(int64(PanicIfInvalidBase(base...)))
+
+	upper := new(big.Int).Exp(b, big.NewInt(int64(digits)), nil)
+	lower := new(big.Int)
+	if digits > 1 {
+		lower.Exp(b, big.NewInt(int64(digits-1)), nil)
+	}
+	upper.Sub(upper, lower)
+	return intToNatural(upper.Add(lower, randomIntBelow(upper)))
+}
+
+// RandomNaturalBelow returns a uniformly random natural number in the half-open interval [0, n).
+//
+// NOTE: Values are drawn through rejection sampling, so there is no modulo bias - regardless of n's width.
+//
+// NOTE: This will panic if n is zero.
+func RandomNaturalBelow(n Natural) Natural {
+	limit := measurementToInt(n.measurement)
+	if limit.Sign() == 0 {
+		panic("cannot generate a random natural below zero")
+	}
+	return intToNatural(randomIntBelow(limit))
+}
+
+// RandomNaturalWithinRange returns a uniformly random natural number in the closed interval [a, b].
+func RandomNaturalWithinRange(a, b Natural) Natural {
+	lower := measurementToInt(a.measurement)
+	upper := measurementToInt(b.measurement)
+	if lower.Cmp(upper) >= 0 {
+		return intToNatural(lower)
+	}
+
+	span := new(big.Int).Sub(upper, lower)
+	span.Add(span, big.NewInt(1))
+	return intToNatural(lower.Add(lower, randomIntBelow(span)))
+}
+
+// RandomNaturalSetWithinRange returns a periodically unique set of uniformly random natural numbers bounded in the
+// closed interval [a, b].
+//
+// This follows the exact exhaustion semantics of RandomSetWithinRange - every value in the range is used once before
+// another round begins, and values are not repeated at the crossover of an exhaustion point (if the available range
+// exceeds 2 unique values).
+func RandomNaturalSetWithinRange(n uint, a, b Natural) []Natural {
+	lower := measurementToInt(a.measurement)
+	upper := measurementToInt(b.measurement)
+	if upper.Cmp(lower) <= 0 {
+		return []Natural{}
+	}
+
+	span := new(big.Int).Sub(upper, lower)
+	span.Add(span, big.NewInt(1))
+
+	// NOTE: A range wider than a uint64 can never be exhausted by a uint-sized set
+	exhaustible := span.IsUint64()
+	size := span.Uint64()
+
+	entries := make(map[string]struct{})
+	out := make([]Natural, 0, n)
+	var last string
+	for uint(len(out)) < n {
+		val := new(big.Int).Add(lower, randomIntBelow(span))
+		key := val.String()
+		if _, exists := entries[key]; exists {
+			continue
+		}
+		if key == last && (!exhaustible || size > 2) {
+			continue
+		}
+
+		entries[key] = struct{}{}
+		out = append(out, intToNatural(val))
+		last = key
+		if exhaustible && uint64(len(entries)) == size {
+			entries = make(map[string]struct{})
+		}
+	}
+	return out
+}
+
+// RandomRealizedWithinRange returns a uniformly random realized number in the closed interval [a, b], calculated to
+// the provided number of fractional placeholders in a's base.
+//
+//	RandomRealizedWithinRange(ParseRealized(-1), ParseRealized(1), 3)  // one of -1.000, -0.999 … 0.999, 1.000
+//
+// NOTE: The result is drawn uniformly from the values of that precision which lie within the range - so if a or b
+// hold more placeholders than requested, the result will never quite reach them.
+func RandomRealizedWithinRange(a, b Realized, precision uint) Realized {
+	base := a.Base()
+	lower := ToRat(&a)
+	upper := ToRat(&b)
+	if lower.Cmp(upper) >= 0 {
+		return FromRat(lower, base)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(precision)), nil)
+	first := ratCeil(new(big.Rat).Mul(lower, new(big.Rat).SetInt(scale)))
+	final := ratFloor(new(big.Rat).Mul(upper, new(big.Rat).SetInt(scale)))
+	if first.Cmp(final) > 0 {
+		panic(fmt.Sprintf("no value of %d placeholders lies within the range [%s, %s]", precision, lower.FloatString(int(precision)), upper.FloatString(int(precision))))
+	}
+
+	span := new(big.Int).Sub(final, first)
+	span.Add(span, big.NewInt(1))
+	step := first.Add(first, randomIntBelow(span))
+	return FromRat(new(big.Rat).SetFrac(step, scale), base)
+}
+
+// randomAdvanced returns a random value within the Numeric's boundaries.
+//
+// NOTE: Unbounded naturals span the addressable range of a uint64, while unbounded realized numbers span the closed
+// interval [0.0, 1.0] to atlas.PrecisionMinimum placeholders.  Complex values are bounded component-wise.
+func randomAdvanced[T Advanced](bnd *Numeric[T]) T {
+	var out T
+	switch typed := any(&out).(type) {
+	case *Natural:
+		if bnd.unbounded {
+			*typed = intToNatural(new(big.Int).SetUint64(entropy.Uint64()))
+			break
+		}
+		*typed = RandomNaturalWithinRange(any(bnd.minimum).(Natural), any(bnd.maximum).(Natural))
+	case *Realized:
+		if bnd.unbounded {
+			*typed = RandomRealizedWithinRange(ParseRealized(0), ParseRealized(1), atlas.PrecisionMinimum)
+			break
+		}
+		*typed = RandomRealizedWithinRange(any(bnd.minimum).(Realized), any(bnd.maximum).(Realized), atlas.PrecisionMinimum)
+	case *complex64:
+		if bnd.unbounded {
+			*typed = complex(Random[float32](), Random[float32]())
+			break
+		}
+		a, b := any(bnd.minimum).(complex64), any(bnd.maximum).(complex64)
+		*typed = complex(RandomWithinRange(real(a), real(b)), RandomWithinRange(imag(a), imag(b)))
+	case *complex128:
+		if bnd.unbounded {
+			*typed = complex(Random[float64](), Random[float64]())
+			break
+		}
+		a, b := any(bnd.minimum).(complex128), any(bnd.maximum).(complex128)
+		*typed = complex(RandomWithinRange(real(a), real(b)), RandomWithinRange(imag(a), imag(b)))
+	}
+	return out
+}
+
+// randomIntBelow returns a uniformly random integer in the half-open interval [0, limit) by rejection sampling the
+// bit width of limit from the global entropy source.
+func randomIntBelow(limit *big.Int) *big.Int {
+	width := limit.BitLen()
+	raw := make([]byte, (width+63)/64*8)
+	out := new(big.Int)
+	for {
+		for i := 0; i < len(raw); i += 8 {
+			binary.BigEndian.PutUint64(raw[i:], entropy.Uint64())
+		}
+		out.SetBytes(raw)
+		out.Rsh(out, uint(len(raw)*8-width))
+		if out.Cmp(limit) < 0 {
+			return out
+		}
+	}
+}
+
+// intToNatural creates a natural number from the provided non-negative integer.
+func intToNatural(value *big.Int) Natural {
+	return Natural{NewMeasurementOfBinaryString(value.Text(2))}
+}
+
+// ratFloor returns the largest integer less than or equal to the provided rational.
+func ratFloor(r *big.Rat) *big.Int {
+	// NOTE: Euclidean division floors whenever the divisor is positive - which a rational's denominator always is
+	return new(big.Int).Div(r.Num(), r.Denom())
+}
+
+// ratCeil returns the smallest integer greater than or equal to the provided rational.
+func ratCeil(r *big.Rat) *big.Int {
+	q, m := new(big.Int).DivMod(r.Num(), r.Denom(), new(big.Int))
+	if m.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
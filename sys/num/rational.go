@@ -0,0 +1,247 @@
+package num
+
+import (
+	"core/sys/atlas"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// ToRat converts the provided operand into an exact rational value.
+//
+// Floating point operands are converted from their exact binary value - not their shortest decimal representation - so
+// float32(0.1) yields 13421773/134217728.  Realized operands are converted from their calculated digits, including any
+// periodic component - so 0.‾3 yields 1/3.
+//
+// NOTE: Irrational values can only be represented to their currently calculated precision.
+//
+// NOTE: This will panic if provided a NaN, Inf, or complex value.
+//
+// See ToRat and FromRat
+func ToRat(operand any) *big.Rat {
+	switch typed := operand.(type) {
+	case *big.Rat:
+		return new(big.Rat).Set(typed)
+	case *big.Int:
+		return new(big.Rat).SetInt(typed)
+	case *big.Float:
+		if typed.IsInf() {
+			panic("cannot convert an Inf valued *big.Float to a rational")
+		}
+		r, _ := typed.Rat(nil)
+		return r
+	case float32:
+		return ToRat(float64(typed))
+	case float64:
+		if math.IsInf(typed, 0) || math.IsNaN(typed) {
+			panic(fmt.Sprintf("cannot convert %v to a rational", typed))
+		}
+		return new(big.Rat).SetFloat64(typed)
+	case Measurement:
+		return new(big.Rat).SetInt(measurementToInt(typed))
+	case Snapshot:
+		return typed.rat()
+	case Natural:
+		return new(big.Rat).SetInt(measurementToInt(typed.measurement))
+	case *Realized:
+		typed.sanityCheck()
+		typed.gate.Lock()
+		defer typed.gate.Unlock()
+		return realizedToRat(typed)
+	case complex64, complex128:
+		panic("cannot convert a complex number to a rational")
+	}
+
+	switch filtered := FilterOperands(10, operand)[0].(type) {
+	case string:
+		r := ParseRealized(filtered)
+		return realizedToRat(&r)
+	case Realized:
+		return ToRat(&filtered)
+	default:
+		return ToRat(filtered)
+	}
+}
+
+// FromRat creates a static realized number holding the exact expansion of the provided rational value in the provided
+// base, or base₁₀ if omitted.
+//
+// A terminating expansion is always calculated in full, regardless of atlas.Precision - so every binary floating point
+// value is exactly representable in base₁₀.  Non-terminating expansions are calculated until their periodic component
+// is found, which is then marked with the ‾ character.  If no period is found within atlas.Precision placeholders, the
+// result is truncated and marked as approximate with the ~ character.
+//
+//	FromRat(big.NewRat(1, 8))   // 0.125
+//	FromRat(big.NewRat(1, 7))   // 0.‾142857
+//	FromRat(big.NewRat(1, 12))  // 0.08‾3
+//
+// See ToRat and FromRat
+func FromRat(r *big.Rat, base ...uint16) Realized {
+	b := PanicIfInvalidBase(base...)
+	realization := expandRat(r, b, atlas.Precision)
+
+	return Realized{&realized{
+		irrational:      realization.Irrational,
+		negative:        realization.Negative,
+		whole:           naturalOfDigits(realization.Whole, b),
+		fractional:      naturalOfDigits(realization.Fractional, b),
+		periodic:        naturalOfDigits(realization.Periodic, b),
+		fractionalWidth: uint(len(realization.Fractional)),
+		periodicWidth:   uint(len(realization.Periodic)),
+		base:            b,
+		precision:       &atlas.Precision,
+		created:         true,
+	}}
+}
+
+// expandRat performs the long division of the provided rational in the provided base, detecting the periodic
+// component by tracking every remainder it encounters.
+func expandRat(r *big.Rat, base uint16, precision uint) Realization {
+	out := Realization{Negative: r.Sign() < 0}
+
+	numerator := new(big.Int).Abs(r.Num())
+	denominator := new(big.Int).Set(r.Denom())
+	b := big.NewInt(int64(base))
+
+	whole, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	out.Whole = intToDigits(whole, base)
+
+	// A rational terminates in a base if its reduced denominator only holds the base's prime factors
+	reduced := new(big.Int).Set(denominator)
+	for gcd := new(big.Int); gcd.GCD(nil, nil, reduced, b).Cmp(big.NewInt(1)) > 0; {
+		reduced.Quo(reduced, gcd)
+	}
+	terminates := reduced.Cmp(big.NewInt(1)) == 0
+
+	seen := make(map[string]int)
+	digit := new(big.Int)
+	for remainder.Sign() != 0 {
+		if !terminates {
+			key := remainder.String()
+			if start, ok := seen[key]; ok {
+				out.Periodic = out.Fractional[start:]
+				out.Fractional = out.Fractional[:start]
+				break
+			}
+			if uint(len(out.Fractional)) >= precision {
+				out.Irrational = true
+				break
+			}
+			seen[key] = len(out.Fractional)
+		}
+
+		remainder.Mul(remainder, b)
+		digit.QuoRem(remainder, denominator, remainder)
+		out.Fractional = append(out.Fractional, byte(digit.Uint64()))
+	}
+
+	if out.Negative && whole.Sign() == 0 && len(out.Fractional) == 0 && len(out.Periodic) == 0 {
+		out.Negative = false
+	}
+	return out
+}
+
+// realizedToRat converts the realized number's calculated digits into a rational value.
+//
+// NOTE: This does not lock the realized number - the caller is expected to.
+func realizedToRat(r *Realized) *big.Rat {
+	whole, fractional, periodic := r.Digits()
+	out := digitsToRat(whole, fractional, periodic, r.base)
+	if r.negative {
+		out.Neg(out)
+	}
+	return out
+}
+
+// digitsToRat converts the provided whole, fractional, and periodic digits into a positive rational value.
+//
+//	𝑤.𝑓‾𝑝 = 𝑤 + 𝑓 / 𝑏ⁿ + 𝑝 / (𝑏ⁿ · (𝑏ᵐ - 1))   where 𝑛 = |𝑓| and 𝑚 = |𝑝|
+func digitsToRat(whole, fractional, periodic []byte, base uint16) *big.Rat {
+	b := big.NewInt(int64(base))
+
+	out := new(big.Rat).SetInt(digitsToInt(whole, base))
+
+	scale := new(big.Int).Exp(b, big.NewInt(int64(len(fractional))), nil)
+	out.Add(out, new(big.Rat).SetFrac(digitsToInt(fractional, base), scale))
+
+	if len(periodic) > 0 {
+		repeat := new(big.Int).Exp(b, big.NewInt(int64(len(periodic))), nil)
+		repeat.Sub(repeat, big.NewInt(1))
+		out.Add(out, new(big.Rat).SetFrac(digitsToInt(periodic, base), new(big.Int).Mul(scale, repeat)))
+	}
+	return out
+}
+
+// digitsToInt interprets the provided most-significant-first digits in the provided base.
+func digitsToInt(digits []byte, base uint16) *big.Int {
+	out := new(big.Int)
+	b := big.NewInt(int64(base))
+	for _, d := range digits {
+		out.Mul(out, b)
+		out.Add(out, big.NewInt(int64(d)))
+	}
+	return out
+}
+
+// intToDigits expresses the provided non-negative integer as most-significant-first digits in the provided base.
+func intToDigits(value *big.Int, base uint16) []byte {
+	if value.Sign() == 0 {
+		return []byte{0}
+	}
+
+	b := big.NewInt(int64(base))
+	v := new(big.Int).Set(value)
+	digit := new(big.Int)
+
+	out := make([]byte, 0)
+	for v.Sign() > 0 {
+		v.QuoRem(v, b, digit)
+		out = append(out, byte(digit.Uint64()))
+	}
+	for l, r := 0, len(out)-1; l < r; l, r = l+1, r-1 {
+		out[l], out[r] = out[r], out[l]
+	}
+	return out
+}
+
+// naturalOfDigits creates a natural number from the provided most-significant-first digits in the provided base.
+func naturalOfDigits(digits []byte, base uint16) Natural {
+	if len(digits) == 0 {
+		return Natural{NewMeasurement()}
+	}
+	return Natural{NewMeasurementOfBinaryString(digitsToInt(digits, base).Text(2))}
+}
+
+// irrationalOfScaled creates an irrational realized number from its absolute value multiplied by 𝑏ᵖ.
+func irrationalOfScaled(scaled *big.Int, negative bool, base uint16, precision uint) Realized {
+	scale := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(precision)), nil)
+	whole, fractional := new(big.Int).QuoRem(scaled, scale, new(big.Int))
+	fractionalDigits := padDigits(intToDigits(fractional, base), precision)
+
+	return Realized{&realized{
+		irrational:      true,
+		negative:        negative && scaled.Sign() != 0,
+		whole:           naturalOfDigits(intToDigits(whole, base), base),
+		fractional:      naturalOfDigits(fractionalDigits, base),
+		periodic:        NaturalZero,
+		fractionalWidth: uint(len(fractionalDigits)),
+		base:            base,
+		precision:       &atlas.Precision,
+		created:         true,
+	}}
+}
+
+// measurementToInt interprets the measurement's bits as an unsigned big endian integer.
+func measurementToInt(m Measurement) *big.Int {
+	out := new(big.Int)
+	for _, b := range m.GetAllBits() {
+		out.Lsh(out, 1)
+		out.SetBit(out, 0, uint(b))
+	}
+	return out
+}
+
+// toInt returns the natural's measurement as an integer.
+func (n Natural) toInt() *big.Int {
+	return measurementToInt(n.measurement)
+}
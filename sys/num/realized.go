@@ -14,17 +14,34 @@ import (
 // ParseRealized - Creates a static realized number.
 //
 // NewRealized - Creates a dynamic realized number.
+//
+// NOTE: A Realized is a handle - copying it copies a reference to the same underlying number, which continues to
+// re-realize under a single lock.  To share a fixed value freely across goroutines, please take a Snapshot.
+//
+// NOTE: The sign and identity are held behind that same lock - see Realized.Negative and Realized.Identity
+//
+// See Realized.Snapshot and Snapshot
 type Realized struct {
+	*realized
+}
+
+// realized is the shared core behind every copy of a Realized handle.
+type realized struct {
 	gate sync.Mutex
 
-	Identity string
+	identity string
 
 	irrational bool
-	Negative   bool
+	negative   bool
 	whole      Natural
 	fractional Natural
 	periodic   Natural
 
+	// NOTE: Naturals cannot hold leading zeros, so the placeholder widths of the fractional
+	// and periodic components are tracked alongside them - 0.05 must not become 0.5!
+	fractionalWidth uint
+	periodicWidth   uint
+
 	revelation func(Realization, uint16, uint) Realization
 	potential  func() bool
 
@@ -55,20 +72,20 @@ type Realized struct {
 //
 // For dynamic number generation, see NewRealized
 func ParseRealized(operand any, base ...uint16) Realized {
-	b := PanicIfInvalidBase(base[0])
+	b := PanicIfInvalidBase(base...)
 	op := ToString(FilterOperands(b, operand)[0])
 
 	if len(op) == 0 {
-		return Realized{
+		return Realized{&realized{
 			irrational: false,
-			Negative:   false,
+			negative:   false,
 			whole:      NaturalZero,
 			fractional: NaturalZero,
 			periodic:   NaturalZero,
 			base:       b,
 			precision:  &atlas.Precision,
 			created:    true,
-		}
+		}}
 	}
 
 	negative := false
@@ -133,15 +150,18 @@ func ParseRealized(operand any, base ...uint16) Realized {
 		periodicPart = strings.Join(periodicDigits, "")
 	}
 
-	return Realized{
-		irrational: irrational,
-		Negative:   negative,
-		whole:      ParseNatural(wholePart, b),
-		fractional: ParseNatural(fractionalPart, b),
-		periodic:   ParseNatural(periodicPart, b),
-		precision:  &atlas.Precision,
-		created:    true,
-	}
+	return Realized{&realized{
+		irrational:      irrational,
+		negative:        negative,
+		whole:           ParseNatural(wholePart, b),
+		fractional:      ParseNatural(fractionalPart, b),
+		periodic:        ParseNatural(periodicPart, b),
+		fractionalWidth: uint(len(fractionalDigits)),
+		periodicWidth:   uint(len(periodicDigits)),
+		base:            b,
+		precision:       &atlas.Precision,
+		created:         true,
+	}}
 }
 
 // NewRealized - Creates a dynamic realized number, which realizes it's value from the provided action potential functions.
@@ -157,9 +177,9 @@ func ParseRealized(operand any, base ...uint16) Realized {
 //
 // For static number generation, see ParseRealized.
 func NewRealized(action func(current Realization, base uint16, precision uint) Realization, potential func() bool, base ...uint16) Realized {
-	b := PanicIfInvalidBase(base[0])
+	b := PanicIfInvalidBase(base...)
 
-	return Realized{
+	return Realized{&realized{
 		whole:      NaturalZero,
 		fractional: NaturalZero,
 		periodic:   NaturalZero,
@@ -168,7 +188,7 @@ func NewRealized(action func(current Realization, base uint16, precision uint) R
 		potential:  potential,
 		base:       b,
 		created:    true,
-	}
+	}}
 }
 
 // SetAction sets the current revelation action, while SetPotential sets its potential function - see.ActionPotentials
@@ -178,6 +198,10 @@ func NewRealized(action func(current Realization, base uint16, precision uint) R
 //
 // For example, if building a realization of π, you might choose to reveal the identity "π" during activation.
 func (r *Realized) SetAction(action func(current Realization, base uint16, precision uint) Realization) {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
 	r.revelation = action
 }
 
@@ -186,11 +210,53 @@ func (r *Realized) SetAction(action func(current Realization, base uint16, preci
 // A potential is tested before a revelation can be fired to determine if the revelation should even
 // take place yet.  This should yield 'true' when a revelation should occur - see.ActionPotentials
 func (r *Realized) SetPotential(potential func() bool) {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
 	r.potential = potential
 }
 
+// Identity returns the identity revealed by the realized number, while SetIdentity sets it.
+func (r *Realized) Identity() string {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
+	return r.identity
+}
+
+// SetIdentity sets the identity of the realized number, while Identity returns it.
+func (r *Realized) SetIdentity(identity string) {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
+	r.identity = identity
+}
+
+// Negative returns whether the realized number currently holds a negative value, while SetNegative sets its sign.
+func (r *Realized) Negative() bool {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
+	return r.negative
+}
+
+// SetNegative sets the sign of the realized number, while Negative returns it.
+//
+// NOTE: A dynamic realized number's action may overwrite the sign upon its next revelation.
+func (r *Realized) SetNegative(negative bool) {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
+	r.negative = negative
+}
+
 func (r *Realized) sanityCheck(base ...uint16) uint16 {
-	if !r.created {
+	if r.realized == nil || !r.created {
 		panic("this realized was not created through a constructor")
 	}
 	return PanicIfInvalidBase(base...)
@@ -219,7 +285,7 @@ func (r *Realized) realize() {
 	whole, fractional, periodic := r.Digits()
 	self := r.revelation(Realization{
 		Irrational: r.irrational,
-		Negative:   r.Negative,
+		Negative:   r.negative,
 		Whole:      whole,
 		Fractional: fractional,
 		Periodic:   periodic,
@@ -250,22 +316,35 @@ func (r *Realized) realize() {
 	}
 
 	r.irrational = self.Irrational
-	r.Negative = self.Negative
+	r.negative = self.Negative
 	r.whole = ParseNatural(self.Whole, r.base)
 	r.fractional = ParseNatural(self.Fractional, r.base)
 	r.periodic = ParseNatural(self.Periodic, r.base)
+	r.fractionalWidth = uint(len(self.Fractional))
+	r.periodicWidth = uint(len(self.Periodic))
 }
 
 func (r *Realized) Digits() (whole []byte, fractional []byte, periodic []byte) {
 	r.sanityCheck()
 
 	whole = r.whole.Digits(r.base)
-	fractional = r.fractional.Digits(r.base)
-	periodic = r.periodic.Digits(r.base)
+	fractional = padDigits(r.fractional.Digits(r.base), r.fractionalWidth)
+	periodic = padDigits(r.periodic.Digits(r.base), r.periodicWidth)
 
 	return whole, fractional, periodic
 }
 
+// padDigits left-pads the provided digits with zeros to the provided width - or returns no digits for a zero width.
+func padDigits(digits []byte, width uint) []byte {
+	if width == 0 {
+		return []byte{}
+	}
+	if uint(len(digits)) >= width {
+		return digits
+	}
+	return append(make([]byte, width-uint(len(digits))), digits...)
+}
+
 // Width returns the number of calculated placeholders in the whole and fractional components.
 //
 // NOTE: For irrational or periodic values, this will return the stored precision for the fractional component.
@@ -281,11 +360,10 @@ func (r *Realized) Width() (whole uint, fractional uint) {
 // Impulse tests the potential and then sparks the Realized number's neural pathway.
 func (r *Realized) Impulse() {
 	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
 
 	if r.potential != nil && r.potential() {
-		r.gate.Lock()
-		defer r.gate.Unlock()
-
 		r.realize()
 	}
 }
@@ -296,10 +374,10 @@ func (r *Realized) Reveal() string {
 	r.sanityCheck()
 
 	// NOTE: This intentionally locks for the entire operation and cannot be replaced with a call to Impulse()
-	if r.potential != nil && r.potential() {
-		r.gate.Lock()
-		defer r.gate.Unlock()
+	r.gate.Lock()
+	defer r.gate.Unlock()
 
+	if r.potential != nil && r.potential() {
 		r.realize()
 	}
 	return r.print(r.base)
@@ -317,10 +395,15 @@ func (r *Realized) Precision(precision ...*uint) uint {
 	r.sanityCheck()
 
 	if len(precision) > 0 {
+		r.gate.Lock()
 		r._precisionNew = precision[0]
 		r._precisionStale = true
+		r.gate.Unlock()
 		r.Impulse()
 	}
+
+	r.gate.Lock()
+	defer r.gate.Unlock()
 	return *r.precision
 }
 
@@ -336,10 +419,16 @@ func (r *Realized) Base(base ...uint16) uint16 {
 	r.sanityCheck()
 
 	if len(base) > 0 {
-		r._baseNew = PanicIfInvalidBase(base[0])
+		b := PanicIfInvalidBase(base...)
+		r.gate.Lock()
+		r._baseNew = b
 		r._baseStale = true
+		r.gate.Unlock()
 		r.Impulse()
 	}
+
+	r.gate.Lock()
+	defer r.gate.Unlock()
 	return r.base
 }
 
@@ -404,17 +493,17 @@ func (r *Realized) Matrix(fractionalWidth int, operands ...any) string {
 
 	wStr := make([]string, len(w))
 	for i, d := range w {
-		wStr[i] = internal.PrintDigit(d)
+		wStr[i] = internal.PrintDigit(d, r.base)
 	}
 
 	fStr := make([]string, len(f))
 	for i, d := range f {
-		fStr[i] = internal.PrintDigit(d)
+		fStr[i] = internal.PrintDigit(d, r.base)
 	}
 
 	pStr := make([]string, len(p))
 	for i, d := range p {
-		pStr[i] = internal.PrintDigit(d)
+		pStr[i] = internal.PrintDigit(d, r.base)
 	}
 	return ""
 }
@@ -425,7 +514,7 @@ func (r *Realized) print(fractionalWidth int, truncateIrrationals bool, base uin
 	if r.irrational {
 		prefix = append(prefix, "~")
 	}
-	if r.Negative {
+	if r.negative {
 		prefix = append(prefix, "-")
 	}
 
@@ -433,17 +522,17 @@ func (r *Realized) print(fractionalWidth int, truncateIrrationals bool, base uin
 
 	wholeStr := make([]string, len(whole))
 	for i, d := range whole {
-		wholeStr[i] = internal.PrintDigit(d)
+		wholeStr[i] = internal.PrintDigit(d, base)
 	}
 
 	fractionalStr := make([]string, len(fractional))
 	for i, d := range fractional {
-		fractionalStr[i] = internal.PrintDigit(d)
+		fractionalStr[i] = internal.PrintDigit(d, base)
 	}
 
 	periodicStr := make([]string, len(periodic))
 	for i, d := range periodic {
-		periodicStr[i] = internal.PrintDigit(d)
+		periodicStr[i] = internal.PrintDigit(d, base)
 	}
 
 	components := append(prefix, wholeStr...)
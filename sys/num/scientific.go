@@ -0,0 +1,193 @@
+package num
+
+import (
+	"core/sys/num/internal"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// PrintScientific prints the realized number in scientific notation to the provided number of significant
+// placeholders, and in the provided base - or base₁₀ if omitted.
+//
+//	"1.2346e-300"      ← base₁₀
+//	"1.0110×2⁵"        ← base₂
+//	"~1.414214e0"      ← √2
+//	"3.‾3e-1"          ← ⅓, whose period fits within the significant placeholders
+//	"~3e-1"            ← ⅓, rounded to a single significant placeholder
+//
+// Values are rounded half away from zero.  Base₁₀ exponents use the familiar 'e' suffix, while every other base writes the exponent as a power of its radix.
+// Just as with Print, irrationals are marked with a tilde [~] - as are any values which had to be rounded to fit.
+// Periodic values whose pattern fits within the significant placeholders are printed exactly with an overscore [‾].
+//
+// NOTE: This will panic if zero significant placeholders are requested.
+//
+// See Realized.PrintScientific, Realized.PrintEngineering, Natural.PrintScientific, and Natural.PrintEngineering
+func (r *Realized) PrintScientific(significant uint, base ...uint16) string {
+	b := r.sanityCheck(base...)
+
+	r.gate.Lock()
+	defer r.gate.Unlock()
+	return printScientific(realizedToRat(r), r.irrational, significant, false, b)
+}
+
+// PrintEngineering prints the realized number just as PrintScientific, but with an exponent which is always a
+// multiple of three - giving the mantissa between one and three whole placeholders.
+//
+//	"12.35e3"    ← 12345 to 4 significant placeholders
+//	"470e-9"     ← 0.00000047 to 2 significant placeholders
+//
+// See Realized.PrintScientific, Realized.PrintEngineering, Natural.PrintScientific, and Natural.PrintEngineering
+func (r *Realized) PrintEngineering(significant uint, base ...uint16) string {
+	b := r.sanityCheck(base...)
+
+	r.gate.Lock()
+	defer r.gate.Unlock()
+	return printScientific(realizedToRat(r), r.irrational, significant, true, b)
+}
+
+// PrintScientific prints the natural number in scientific notation - see Realized.PrintScientific
+func (n Natural) PrintScientific(significant uint, base ...uint16) string {
+	return printScientific(new(big.Rat).SetInt(n.toInt()), false, significant, false, PanicIfInvalidBase(base...))
+}
+
+// PrintEngineering prints the natural number in engineering notation - see Realized.PrintEngineering
+func (n Natural) PrintEngineering(significant uint, base ...uint16) string {
+	return printScientific(new(big.Rat).SetInt(n.toInt()), false, significant, true, PanicIfInvalidBase(base...))
+}
+
+// printScientific renders 𝑚 × 𝑏ᵉ, where the mantissa 𝑚 holds the requested number of significant placeholders.
+func printScientific(x *big.Rat, irrational bool, significant uint, engineering bool, base uint16) string {
+	if significant == 0 {
+		panic("cannot print fewer than one significant placeholder")
+	}
+
+	negative := x.Sign() < 0
+	x = new(big.Rat).Abs(x)
+
+	exponent := 0
+	if x.Sign() != 0 {
+		exponent = exponentOf(x, base)
+	}
+	shift := 0
+	if engineering {
+		// Floor the exponent to a multiple of three, moving the difference into the mantissa's whole part
+		shift = ((exponent % 3) + 3) % 3
+		exponent -= shift
+	}
+	mantissa := new(big.Rat).Mul(x, ratPow(base, -exponent))
+
+	// A periodic value is printed exactly if its full pattern fits
+	if !irrational && x.Sign() != 0 {
+		expansion := expandRat(mantissa, base, significant)
+		width := uint(len(expansion.Whole) + len(expansion.Fractional) + len(expansion.Periodic))
+		if len(expansion.Periodic) > 0 && width <= significant {
+			return composeScientific(false, negative, expansion.Whole, expansion.Fractional, expansion.Periodic, exponent, base)
+		}
+	}
+
+	// Round half away from zero to the significant placeholders
+	scaled := new(big.Rat).Mul(mantissa, ratPow(base, int(significant)-(shift+1)))
+	rounded := ratFloor(new(big.Rat).Add(scaled, big.NewRat(1, 2)))
+	inexact := new(big.Rat).SetInt(rounded).Cmp(scaled) != 0
+
+	if x.Sign() != 0 && rounded.Cmp(new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(significant)), nil)) == 0 {
+		// Rounding carried into another whole placeholder - as the result is a power of the base, nothing is lost
+		rounded.Quo(rounded, big.NewInt(int64(base)))
+		if !engineering {
+			exponent++
+		} else if shift++; shift == 3 {
+			shift = 0
+			exponent += 3
+		}
+	}
+
+	digits := padDigits(intToDigits(rounded, base), significant)
+	wholeWidth := shift + 1
+	if len(digits) < wholeWidth {
+		// Not enough significant placeholders to fill the whole part, so pad it with zeros
+		digits = append(digits, make([]byte, wholeWidth-len(digits))...)
+	}
+	return composeScientific(irrational || inexact, negative, digits[:wholeWidth], digits[wholeWidth:], nil, exponent, base)
+}
+
+// composeScientific joins the components of a scientific number in the standard see.PrintingNumbers form.
+func composeScientific(approximate, negative bool, whole, fractional, periodic []byte, exponent int, base uint16) string {
+	var components []string
+	if approximate {
+		components = append(components, "~")
+	}
+	if negative {
+		components = append(components, "-")
+	}
+	for _, d := range whole {
+		components = append(components, internal.PrintDigit(d, base))
+	}
+	if len(fractional) > 0 || len(periodic) > 0 {
+		components = append(components, ".")
+		for _, d := range fractional {
+			components = append(components, internal.PrintDigit(d, base))
+		}
+		if len(periodic) > 0 {
+			components = append(components, "‾")
+			for _, d := range periodic {
+				components = append(components, internal.PrintDigit(d, base))
+			}
+		}
+	}
+
+	if base == 10 {
+		return strings.Join(components, "") + "e" + strconv.Itoa(exponent)
+	}
+
+	suffix := "×" + strconv.Itoa(int(base)) + superscript(exponent)
+	if base > 16 {
+		return strings.Join(append(components, suffix), " ")
+	}
+	return strings.Join(components, "") + suffix
+}
+
+// exponentOf finds 𝑒 such that 𝑏ᵉ ≤ 𝑥 < 𝑏ᵉ⁺¹ for a positive rational.
+func exponentOf(x *big.Rat, base uint16) int {
+	estimate := float64(x.Num().BitLen()-x.Denom().BitLen()) / math.Log2(float64(base))
+	e := int(math.Floor(estimate))
+	for x.Cmp(ratPow(base, e)) < 0 {
+		e--
+	}
+	for x.Cmp(ratPow(base, e+1)) >= 0 {
+		e++
+	}
+	return e
+}
+
+// ratPow returns 𝑏ᵉ as a rational, for any integer exponent.
+func ratPow(base uint16, exponent int) *big.Rat {
+	magnitude := exponent
+	if magnitude < 0 {
+		magnitude = -magnitude
+	}
+	power := new(big.Int).Exp(big.NewInt(int64(base)), big.NewInt(int64(magnitude)), nil)
+	if exponent < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), power)
+	}
+	return new(big.Rat).SetInt(power)
+}
+
+// superscript writes the provided integer in superscript characters.
+func superscript(value int) string {
+	const digits = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+	runes := []rune(digits)
+
+	builder := strings.Builder{}
+	if value < 0 {
+		builder.WriteRune('⁻')
+	}
+	for _, c := range strconv.Itoa(value) {
+		if c == '-' {
+			continue
+		}
+		builder.WriteRune(runes[c-'0'])
+	}
+	return builder.String()
+}
@@ -0,0 +1,125 @@
+package num
+
+import (
+	"math/big"
+	"slices"
+)
+
+// A Snapshot is an immutable copy of a Realized number's value at the moment it was taken.
+//
+// While a Realized handle continues to re-realize its value under a lock, a Snapshot never changes - so it can be
+// shared freely across goroutines without any synchronization.  Every accessor returns a copy of its data.
+//
+//	live := NewRealized(action, potential)
+//	snap := live.Snapshot()   // a stable value to hand off
+//	live.Impulse()            // the live number moves on, while snap does not
+//
+// NOTE: Snapshots are FilterOperands compatible, resolving to a new static Realized number.
+//
+// See Realized.Snapshot, Snapshot.Realized, and Snapshot.Realization
+type Snapshot struct {
+	identity   string
+	irrational bool
+	negative   bool
+	whole      []byte
+	fractional []byte
+	periodic   []byte
+	base       uint16
+	precision  uint
+}
+
+// Snapshot captures the realized number's currently calculated value as an immutable Snapshot.
+//
+// NOTE: This does not impulse the number - please call Impulse first if its latest value is required.
+func (r *Realized) Snapshot() Snapshot {
+	r.sanityCheck()
+	r.gate.Lock()
+	defer r.gate.Unlock()
+
+	whole, fractional, periodic := r.Digits()
+	return Snapshot{
+		identity:   r.identity,
+		irrational: r.irrational,
+		negative:   r.negative,
+		whole:      slices.Clone(whole),
+		fractional: slices.Clone(fractional),
+		periodic:   slices.Clone(periodic),
+		base:       r.base,
+		precision:  *r.precision,
+	}
+}
+
+// Identity returns the identity the realized number held when the Snapshot was taken.
+func (s Snapshot) Identity() string {
+	return s.identity
+}
+
+// Irrational returns whether the Snapshot holds an irrational approximation.
+func (s Snapshot) Irrational() bool {
+	return s.irrational
+}
+
+// Negative returns whether the Snapshot holds a negative value.
+func (s Snapshot) Negative() bool {
+	return s.negative
+}
+
+// Base returns the base the Snapshot's digits are expressed in.
+func (s Snapshot) Base() uint16 {
+	return s.base
+}
+
+// Precision returns the precision the realized number was calculated to when the Snapshot was taken.
+func (s Snapshot) Precision() uint {
+	return s.precision
+}
+
+// Digits returns a copy of the Snapshot's whole, fractional, and periodic digits.
+func (s Snapshot) Digits() (whole []byte, fractional []byte, periodic []byte) {
+	return slices.Clone(s.whole), slices.Clone(s.fractional), slices.Clone(s.periodic)
+}
+
+// Realization returns a copy of the Snapshot as a Realization.
+func (s Snapshot) Realization() Realization {
+	whole, fractional, periodic := s.Digits()
+	return Realization{
+		Irrational: s.irrational,
+		Negative:   s.negative,
+		Whole:      whole,
+		Fractional: fractional,
+		Periodic:   periodic,
+	}
+}
+
+// Realized creates a new static realized number holding the Snapshot's value - which is independent of both the
+// Snapshot and the number it was taken from.
+func (s Snapshot) Realized() Realized {
+	precision := s.precision
+	return Realized{&realized{
+		identity:        s.identity,
+		irrational:      s.irrational,
+		negative:        s.negative,
+		whole:           naturalOfDigits(s.whole, s.base),
+		fractional:      naturalOfDigits(s.fractional, s.base),
+		periodic:        naturalOfDigits(s.periodic, s.base),
+		fractionalWidth: uint(len(s.fractional)),
+		periodicWidth:   uint(len(s.periodic)),
+		base:            s.base,
+		precision:       &precision,
+		created:         true,
+	}}
+}
+
+// String - see.PrintingNumbers
+func (s Snapshot) String() string {
+	return FormatPlain.compose(s.irrational, s.negative, s.whole, s.fractional, s.periodic, s.base)
+}
+
+// rat returns the Snapshot's exact calculated value.
+func (s Snapshot) rat() *big.Rat {
+	out := digitsToRat(s.whole, s.fractional, s.periodic, s.base)
+	if s.negative {
+		out.Neg(out)
+	}
+	return out
+}
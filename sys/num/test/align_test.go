@@ -0,0 +1,69 @@
+package test
+
+import (
+	"core/sys/num"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func alignCheck(t *testing.T, out []string, expected ...string) {
+	if len(out) != len(expected) {
+		t.Fatalf("expected %d results, got %d", len(expected), len(out))
+	}
+	for i := range expected {
+		if out[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], out[i])
+		}
+	}
+}
+
+func Test_ToStringAligned_Primitives(t *testing.T) {
+	alignCheck(t, num.ToStringAligned(1.5, -22, 0.125),
+		" 01.500",
+		"-22.000",
+		" 00.125",
+	)
+}
+
+func Test_ToStringAligned_Integers(t *testing.T) {
+	alignCheck(t, num.ToStringAligned(5, -120, num.ParseNatural(42)),
+		" 005",
+		"-120",
+		" 042",
+	)
+}
+
+func Test_ToStringAligned_PeriodicAndIrrational(t *testing.T) {
+	third := num.FromRat(big.NewRat(-1, 3))
+	alignCheck(t, num.ToStringAligned("~1.4142", third, 12.5),
+		"~ 01.4142",
+		" -00.‾3  ",
+		"  12.5000",
+	)
+}
+
+func Test_ToStringAlignedWith_Placeholders(t *testing.T) {
+	options := num.AlignOptions{NaN: "NaN", Inf: "Inf"}
+	alignCheck(t, num.ToStringAlignedWith(options, 1.25, math.NaN(), math.Inf(-1)),
+		"   1.25",
+		" NaN   ",
+		"-Inf   ",
+	)
+}
+
+func Test_ToStringAlignedWith_SpacedPlaceholders(t *testing.T) {
+	alignCheck(t, num.ToStringAlignedWith(num.AlignOptions{Base: 17}, num.ParseNatural(17*17), 1),
+		"  01 00 00",
+		"  00 00 01",
+	)
+}
+
+func Test_ToStringAligned_Panics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected aligning NaN without a placeholder to panic")
+		}
+	}()
+	num.ToStringAligned(math.NaN())
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"core/sys/num"
+	"core/sys/num/internal"
+	"testing"
+)
+
+func Test_PanicIfInvalidBase(t *testing.T) {
+	if b := num.PanicIfInvalidBase(); b != 10 {
+		t.Errorf("expected an omitted base to default to 10, got %d", b)
+	}
+	if b := num.PanicIfInvalidBase(16); b != 16 {
+		t.Errorf("expected base 16, got %d", b)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected base 1 to panic")
+		}
+	}()
+	num.PanicIfInvalidBase(1)
+}
+
+func Test_PrintDigit_Base(t *testing.T) {
+	cases := []struct {
+		digit    byte
+		base     uint16
+		expected string
+	}{
+		{5, 10, "5"},
+		{15, 16, "f"},
+		{15, 17, "0f"},
+		{255, 256, "ff"},
+	}
+	for _, c := range cases {
+		if out := internal.PrintDigit(c.digit, c.base); out != c.expected {
+			t.Errorf("expected %d to print as %s in base %d, got %s", c.digit, c.expected, c.base, out)
+		}
+	}
+}
+
+func Test_Base_DigitsToString_SourceBase(t *testing.T) {
+	if out, _ := num.Base.DigitsToString([]byte{1, 0}, 17, 10); out != "17" {
+		t.Errorf("expected 10₁₇ to convert to 17, got %s", out)
+	}
+	if out, _ := num.Base.DigitsToString([]byte{1, 7}, 10, 17); out != "01 00" {
+		t.Errorf("expected 17 to convert to 01 00, got %s", out)
+	}
+}
+
+func Test_Natural_Print_Base(t *testing.T) {
+	cases := []struct {
+		value    int
+		base     uint16
+		expected string
+	}{
+		{42, 10, "42"},
+		{255, 16, "ff"},
+		{17, 17, "01 00"},
+	}
+	for _, c := range cases {
+		if out := num.ParseNatural(c.value).Print(c.base); out != c.expected {
+			t.Errorf("expected %d to print as %s in base %d, got %s", c.value, c.expected, c.base, out)
+		}
+	}
+	if out := num.ParseNatural(42).Print(); out != "42" {
+		t.Errorf("expected an omitted base to print in base 10, got %s", out)
+	}
+}
+
+func Test_ParseRealized_Base(t *testing.T) {
+	r := num.ParseRealized("ff", 16)
+	if b := r.Base(); b != 16 {
+		t.Errorf("expected base 16, got %d", b)
+	}
+}
+
+func Test_ParseRealized_PlaceholderWidths(t *testing.T) {
+	r := num.ParseRealized("0.05")
+	if _, fractional, _ := r.Digits(); len(fractional) != 2 || fractional[0] != 0 || fractional[1] != 5 {
+		t.Errorf("expected the fractional digits [0 5], got %v", fractional)
+	}
+
+	p := num.ParseRealized("1.2‾03")
+	if _, _, periodic := p.Digits(); len(periodic) != 2 || periodic[0] != 0 || periodic[1] != 3 {
+		t.Errorf("expected the periodic digits [0 3], got %v", periodic)
+	}
+}
@@ -0,0 +1,108 @@
+package test
+
+import (
+	"core/sys/num"
+	"math/big"
+	"testing"
+)
+
+func near(value *big.Rat, expected string, placeholders int64) bool {
+	epsilon := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(placeholders), nil))
+	difference := new(big.Rat).Sub(value, num.ToRat(expected))
+	return difference.Abs(difference).Cmp(epsilon) <= 0
+}
+
+func parts(z num.RealizedComplex) (*big.Rat, *big.Rat) {
+	return num.ToRat(z.Real()), num.ToRat(z.Imaginary())
+}
+
+func Test_Complex_Arithmetic(t *testing.T) {
+	a := num.ParseComplex(3, 2)
+	b := num.ParseComplex(1, -4)
+
+	cases := map[string]struct {
+		z      num.RealizedComplex
+		re, im *big.Rat
+	}{
+		"add":       {a.Add(b), big.NewRat(4, 1), big.NewRat(-2, 1)},
+		"sub":       {a.Sub(b), big.NewRat(2, 1), big.NewRat(6, 1)},
+		"mul":       {a.Mul(b), big.NewRat(11, 1), big.NewRat(-10, 1)},
+		"div":       {a.Div(b), big.NewRat(-5, 17), big.NewRat(14, 17)},
+		"conjugate": {a.Conjugate(), big.NewRat(3, 1), big.NewRat(-2, 1)},
+	}
+	for name, c := range cases {
+		re, im := parts(c.z)
+		if re.Cmp(c.re) != 0 || im.Cmp(c.im) != 0 {
+			t.Errorf("%s: expected %v + %vi, got %v + %vi", name, c.re, c.im, re, im)
+		}
+	}
+}
+
+func Test_Complex_Div_Zero(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic when dividing by zero")
+		}
+	}()
+	num.ParseComplex(1, 1).Div(num.ParseComplex(0, 0))
+}
+
+func Test_Complex_Magnitude(t *testing.T) {
+	exact := num.ParseComplex(3, 4).Magnitude()
+	if value := num.ToRat(&exact); value.Cmp(big.NewRat(5, 1)) != 0 {
+		t.Errorf("expected 5, got %v", value)
+	}
+
+	root := num.ParseComplex(1, 1).Magnitude()
+	if value := num.ToRat(&root); !near(value, "1.41421356237309504880168872420969807856967187537694", 50) {
+		t.Errorf("expected √2, got %v", value.FloatString(50))
+	}
+}
+
+func Test_Complex_Polar(t *testing.T) {
+	magnitude, angle := num.ParseComplex(-1, 0).Polar()
+	if value := num.ToRat(&magnitude); value.Cmp(big.NewRat(1, 1)) != 0 {
+		t.Errorf("expected a magnitude of 1, got %v", value)
+	}
+	if value := num.ToRat(&angle); !near(value, "3.14159265358979323846264338327950288419716939937510", 50) {
+		t.Errorf("expected π, got %v", value.FloatString(50))
+	}
+
+	_, angle = num.ParseComplex(0, -2).Polar()
+	if value := num.ToRat(&angle); !near(value, "-1.57079632679489661923132169163975144209858469968755", 50) {
+		t.Errorf("expected -π/2, got %v", value.FloatString(50))
+	}
+
+	z := num.FromPolar(num.ParseComplex(1, 1).Magnitude(), angleOf(num.ParseComplex(1, 1)))
+	re, im := parts(z)
+	if !near(re, "1", 50) || !near(im, "1", 50) {
+		t.Errorf("expected 1 + 1i, got %v + %vi", re.FloatString(50), im.FloatString(50))
+	}
+}
+
+func angleOf(z num.RealizedComplex) *num.Realized {
+	angle := z.Angle()
+	return &angle
+}
+
+func Test_Complex_Irrational_PerPart(t *testing.T) {
+	root := num.ParseComplex(1, 1).Magnitude()
+	z := num.ParseComplex(root, 0)
+
+	cases := map[string]struct {
+		z      num.RealizedComplex
+		re, im bool
+	}{
+		"add": {z.Add(num.ParseComplex(1, 1)), true, false},
+		"sub": {num.ParseComplex(1, 1).Sub(z), true, false},
+		"mul": {z.Mul(num.ParseComplex(2, 0)), true, false},
+		"div": {z.Div(num.ParseComplex(2, 0)), true, false},
+		"rot": {z.Mul(num.ParseComplex(0, 1)), false, true},
+	}
+	for name, c := range cases {
+		re, im := c.z.Real().Snapshot().Irrational(), c.z.Imaginary().Snapshot().Irrational()
+		if re != c.re || im != c.im {
+			t.Errorf("%s: expected irrational parts (%v, %v), got (%v, %v)", name, c.re, c.im, re, im)
+		}
+	}
+}
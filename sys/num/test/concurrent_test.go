@@ -0,0 +1,78 @@
+package test
+
+import (
+	"core/enum/overflow"
+	"core/sys/num"
+	"sync"
+	"testing"
+)
+
+func Test_Concurrent_Increment(t *testing.T) {
+	n, _ := num.NewNumericWithPolicy(0, 0, 1_000_000, overflow.Report)
+	counter := num.NewConcurrent(n)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				counter.Increment()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if counter.Value() != 8000 {
+		t.Errorf("8 goroutines × 1000 increments = %v, want 8000", counter.Value())
+	}
+}
+
+func Test_Concurrent_Float(t *testing.T) {
+	n, _ := num.NewNumericBounded(0.0, 0.0, 10.0)
+	value := num.NewConcurrent(n)
+
+	// 4 goroutines × 40 × ±0.5 = ±80, well beyond either boundary
+	saturate := func(amount float64) {
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 40; i++ {
+					value.AddOrSubtract(amount)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	saturate(0.5)
+	if value.Value() != 10 {
+		t.Errorf("clamped float = %v, want 10", value.Value())
+	}
+	if breach := value.AddOrSubtract(0.5); breach == "" || value.Value() != 10 {
+		t.Errorf("expected adding beyond the maximum to report a breach and hold at 10, got %v (%q)", value.Value(), breach)
+	}
+
+	saturate(-0.5)
+	if value.Value() != 0 {
+		t.Errorf("clamped float = %v, want 0", value.Value())
+	}
+}
+
+func Test_Concurrent_CompareAndSwap(t *testing.T) {
+	n, _ := num.NewNumericBounded[uint](3, 0, 7)
+	c := num.NewConcurrent(n)
+
+	if swapped, _ := c.CompareAndSwap(2, 5); swapped {
+		t.Errorf("swapped against a stale value")
+	}
+	if swapped, breach := c.CompareAndSwap(3, 9); !swapped || breach != "2" || c.Value() != 1 {
+		t.Errorf("CompareAndSwap(3, 9) = %v (%v), want 1 (2)", c.Value(), breach)
+	}
+
+	if breach := c.SetBoundaries(4, 6); c.Value() != 4 || breach == "" {
+		t.Errorf("SetBoundaries(4, 6) = %v (%v), want 4", c.Value(), breach)
+	}
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"core/sys/num"
+	"math/big"
+	"testing"
+)
+
+func naturals(values ...int) []num.Natural {
+	out := make([]num.Natural, len(values))
+	for i, v := range values {
+		out[i] = num.ParseNatural(v)
+	}
+	return out
+}
+
+func Test_Realized_ContinuedFraction(t *testing.T) {
+	r := num.ParseRealized("3.245")
+	terms := r.ContinuedFraction(10)
+
+	expected := []string{"3", "4", "12", "4"}
+	if len(terms) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, terms)
+	}
+	for i, term := range terms {
+		if term.String() != expected[i] {
+			t.Errorf("expected %v, got %v", expected, terms)
+		}
+	}
+}
+
+func Test_Realized_BestRational(t *testing.T) {
+	pi := num.ParseRealized("3.14159265358979")
+	if best := pi.BestRational(num.ParseNatural(10)); best.Cmp(big.NewRat(22, 7)) != 0 {
+		t.Errorf("expected 22/7, got %v", best)
+	}
+	if best := pi.BestRational(num.ParseNatural(1000)); best.Cmp(big.NewRat(355, 113)) != 0 {
+		t.Errorf("expected 355/113, got %v", best)
+	}
+
+	// 311/99 is a semiconvergent - lying between the convergents 22/7 and 333/106
+	if best := pi.BestRational(num.ParseNatural(100)); best.Cmp(big.NewRat(311, 99)) != 0 {
+		t.Errorf("expected 311/99, got %v", best)
+	}
+}
+
+func Test_FromContinuedFraction(t *testing.T) {
+	r := num.FromContinuedFraction(naturals(3, 4, 12, 4))
+	if value := num.ToRat(&r); value.Cmp(big.NewRat(649, 200)) != 0 {
+		t.Errorf("expected 3.245, got %v", value)
+	}
+}
+
+func Test_FromPeriodicContinuedFraction_Sqrt2(t *testing.T) {
+	sqrt2 := num.FromPeriodicContinuedFraction(naturals(1), naturals(2))
+
+	value := num.ToRat(&sqrt2)
+	squared := new(big.Rat).Mul(value, value)
+	epsilon := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(200), nil))
+	if difference := new(big.Rat).Sub(big.NewRat(2, 1), squared); difference.Sign() < 0 || difference.Cmp(epsilon) > 0 {
+		t.Errorf("expected √2 squared to approach 2 from below, got a difference of %v", difference.FloatString(8))
+	}
+
+	terms := sqrt2.ContinuedFraction(12)
+	for i, term := range terms {
+		expected := "2"
+		if i == 0 {
+			expected = "1"
+		}
+		if term.String() != expected {
+			t.Fatalf("expected [1; 2, 2, 2 …], got %v", terms)
+		}
+	}
+
+	if best := sqrt2.BestRational(num.ParseNatural(1000)); best.Cmp(big.NewRat(1393, 985)) != 0 {
+		t.Errorf("expected 1393/985, got %v", best)
+	}
+}
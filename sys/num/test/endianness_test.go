@@ -0,0 +1,68 @@
+package test
+
+import (
+	"core/enum/endian"
+	"core/sys/num"
+	"testing"
+)
+
+func Test_NewMeasurementOf_Endianness(t *testing.T) {
+	tests := []struct {
+		name   string
+		endian endian.Endianness
+		want   string
+	}{
+		{"Big", endian.Big, "010011010010110000010110"},
+		{"Little", endian.Little, "000101100010110001001101"},
+		{"BitwiseLittle", endian.BitwiseLittle, "011010000011010010110010"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := num.NewMeasurementOf[uint32](5_057_558, tt.endian)
+			if m.Endianness != tt.endian {
+				t.Errorf("Endianness = %v, want %v", m.Endianness, tt.endian)
+			}
+
+			// NOTE: The most significant byte is always zero for this value, so only check the populated bytes
+			got := m.String()
+			switch tt.endian {
+			case endian.Big:
+				got = got[8:]
+			default:
+				got = got[:24]
+			}
+			if got != tt.want {
+				t.Errorf("NewMeasurementOf(5_057_558, %v) = %v, want %v", tt.endian, got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_Measurement_ToEndian_RoundTrip(t *testing.T) {
+	forms := []endian.Endianness{endian.Big, endian.Little, endian.BitwiseLittle}
+	original := num.NewMeasurementOf[int64](-1234567890123)
+	for _, a := range forms {
+		for _, b := range forms {
+			m := original.ToEndian(a).ToEndian(b)
+			if m.Endianness != b {
+				t.Errorf("ToEndian(%v).ToEndian(%v) recorded %v", a, b, m.Endianness)
+			}
+			if v := num.DecodeMeasurement[int64](m); v != -1234567890123 {
+				t.Errorf("DecodeMeasurement after %v → %v = %v, want %v", a, b, v, -1234567890123)
+			}
+		}
+	}
+}
+
+func Test_DecodeMeasurement_Struct(t *testing.T) {
+	type sample struct {
+		A uint16
+		B float32
+		C [3]byte
+	}
+	want := sample{A: 42, B: 3.5, C: [3]byte{7, 8, 9}}
+	m := num.NewMeasurementOf(want, endian.BitwiseLittle)
+	if got := num.DecodeMeasurement[sample](m); got != want {
+		t.Errorf("DecodeMeasurement = %+v, want %+v", got, want)
+	}
+}
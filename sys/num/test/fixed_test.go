@@ -0,0 +1,58 @@
+package test
+
+import (
+	"core/sys/num"
+	"testing"
+)
+
+func Test_Fixed_Q15(t *testing.T) {
+	half, _ := num.Q15.New(0.5)
+	quarter, _ := num.Q15.New("0.25")
+
+	product, breach := half.Mul(quarter)
+	if product.String() != "0.125" || breach != "" {
+		t.Errorf("0.5 * 0.25 = %v (%v), want 0.125", product, breach)
+	}
+
+	tiny, _ := num.Q15.FromRaw(1)
+	if got := tiny.String(); got != "0.000030517578125" {
+		t.Errorf("Q15 resolution = %v, want 0.000030517578125", got)
+	}
+
+	if m := half.Measurement(); m.String() != "0100000000000000" {
+		t.Errorf("Q15(0.5).Measurement() = %v", m.String())
+	}
+	if back := num.Q15.Decode(num.NewMeasurementOfBinaryString("1100000000000000")); back.String() != "-0.5" {
+		t.Errorf("Q15.Decode(1100000000000000) = %v, want -0.5", back)
+	}
+}
+
+func Test_Fixed_Overflow(t *testing.T) {
+	a, _ := num.Q8_8.New(100)
+	b, _ := num.Q8_8.New(100)
+
+	wrapped, breach := a.Add(b)
+	if wrapped.String() != "-56" || breach == "" {
+		t.Errorf("wrapping 100 + 100 = %v (%v), want -56", wrapped, breach)
+	}
+
+	saturated, _ := a.Saturate(true).Add(b)
+	if saturated.String() != "127.99609375" {
+		t.Errorf("saturating 100 + 100 = %v, want 127.99609375", saturated)
+	}
+
+	minimum, _ := num.Q15.New(-1, true)
+	if negated, _ := minimum.Neg(); negated.Raw() != 32767 {
+		t.Errorf("saturating -(-1) = %v, want 32767 raw", negated.Raw())
+	}
+}
+
+func Test_Fixed_Div(t *testing.T) {
+	one, _ := num.Q16_16.New(1)
+	three, _ := num.Q16_16.New(3)
+
+	third, _ := one.Div(three)
+	if third.Raw() != 21845 {
+		t.Errorf("1 / 3 = %v raw, want 21845", third.Raw())
+	}
+}
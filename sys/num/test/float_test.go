@@ -0,0 +1,69 @@
+package test
+
+import (
+	"core/sys/num"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func Test_FloatFormat_DecodeExact(t *testing.T) {
+	half := num.Binary16.Encode("0.1")
+	if half.String() != "0010111001100110" {
+		t.Fatalf("Binary16.Encode(0.1) = %v", half.String())
+	}
+
+	r := num.Binary16.Decode(half)
+	if got := r.String(); got != "0.0999755859375" {
+		t.Errorf("Binary16.Decode = %v, want 0.0999755859375", got)
+	}
+
+	single := num.NewMeasurementOf[float32](0.1)
+	r = num.Binary32.Decode(single)
+	if got := r.String(); got != "0.100000001490116119384765625" {
+		t.Errorf("Binary32.Decode = %v, want 0.100000001490116119384765625", got)
+	}
+}
+
+func Test_FloatFormat_EncodeMatchesHardware(t *testing.T) {
+	for _, v := range []float64{0, 1, -2.5, math.Pi, 1e-40, 3.4028235e38, 1e39, math.SmallestNonzeroFloat32 / 2} {
+		want := fmt.Sprintf("%032b", math.Float32bits(float32(v)))
+		if got := num.Binary32.Encode(v).String(); got != want {
+			t.Errorf("Binary32.Encode(%v) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func Test_FloatFormat_Specials(t *testing.T) {
+	if !num.Binary16.IsNaN(num.Binary16.NaN()) {
+		t.Errorf("NaN was not detected")
+	}
+	if inf, negative := num.Binary16.IsInf(num.Binary16.Encode(-65520)); !inf || !negative {
+		t.Errorf("-65520 should overflow a binary16 to -Inf")
+	}
+
+	e5m2 := num.Minifloat(5, 2)
+	if e5m2.Width() != 8 || e5m2.Encode(0.3).String() != "00110101" {
+		t.Errorf("E5M2.Encode(0.3) = %v", e5m2.Encode(0.3).String())
+	}
+}
+
+func Test_FromRat(t *testing.T) {
+	cases := map[string]*big.Rat{
+		"0.125":     big.NewRat(1, 8),
+		"0.‾142857": big.NewRat(1, 7),
+		"-0.08‾3":   big.NewRat(-1, 12),
+		"22":        big.NewRat(22, 1),
+	}
+
+	for want, rat := range cases {
+		r := num.FromRat(rat)
+		if got := r.String(); got != want {
+			t.Errorf("FromRat(%v) = %v, want %v", rat, got, want)
+		}
+		if back := num.ToRat(&r); back.Cmp(rat) != 0 {
+			t.Errorf("ToRat(%v) = %v, want %v", want, back, rat)
+		}
+	}
+}
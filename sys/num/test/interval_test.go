@@ -0,0 +1,95 @@
+package test
+
+import (
+	"core/sys/atlas"
+	"core/sys/num"
+	"math/big"
+	"testing"
+)
+
+func Test_Interval_Arithmetic(t *testing.T) {
+	a := num.NewInterval("1.41421", "1.41422")
+	b := num.NewInterval("1.73205", "1.73206")
+
+	sum := a.Add(b)
+	if sum.Lower().Cmp(num.ToRat("3.14626")) != 0 || sum.Upper().Cmp(num.ToRat("3.14628")) != 0 {
+		t.Errorf("expected [3.14626, 3.14628], got [%v, %v]", sum.Lower(), sum.Upper())
+	}
+	if p := sum.Placeholders(); p != 4 {
+		t.Errorf("expected 4 placeholders, got %d", p)
+	}
+
+	difference := a.Sub(b)
+	if difference.Lower().Cmp(num.ToRat("-0.31785")) != 0 || difference.Upper().Cmp(num.ToRat("-0.31783")) != 0 {
+		t.Errorf("expected [-0.31785, -0.31783], got [%v, %v]", difference.Lower(), difference.Upper())
+	}
+
+	product := a.Mul(b.Neg())
+	if !product.Contains(num.ToRat("-2.449489")) || product.Upper().Sign() >= 0 {
+		t.Errorf("expected the product to contain -√6, got [%v, %v]", product.Lower(), product.Upper())
+	}
+
+	quotient := b.Div(a)
+	if !quotient.Contains(num.ToRat("1.22474")) {
+		t.Errorf("expected the quotient to contain √1.5, got [%v, %v]", quotient.Lower(), quotient.Upper())
+	}
+}
+
+func Test_Interval_Straddling(t *testing.T) {
+	a := num.NewInterval("1.4142", "1.4143")
+	square := a.Mul(a)
+	if !square.Contains(2) {
+		t.Errorf("expected the square to contain 2, got [%v, %v]", square.Lower(), square.Upper())
+	}
+	if p := square.Placeholders(); p != 0 {
+		t.Errorf("expected no guaranteed placeholders, got %d", p)
+	}
+}
+
+func Test_Interval_Div_Zero(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic when dividing by an interval containing zero")
+		}
+	}()
+	num.NewInterval(1, 2).Div(num.NewInterval(-1, 1))
+}
+
+func Test_IntervalOf_Irrational(t *testing.T) {
+	sqrt2 := num.FromPeriodicContinuedFraction(naturals(1), naturals(2))
+	i := num.IntervalOf(&sqrt2)
+
+	lower, upper := i.Lower(), i.Upper()
+	if new(big.Rat).Mul(lower, lower).Cmp(big.NewRat(2, 1)) >= 0 || new(big.Rat).Mul(upper, upper).Cmp(big.NewRat(2, 1)) <= 0 {
+		t.Error("expected the interval to contain √2")
+	}
+	if p := i.Placeholders(); p < atlas.Precision-2 {
+		t.Errorf("expected roughly %d placeholders, got %d", atlas.Precision, p)
+	}
+
+	exact := num.IntervalOf(num.ParseRealized("0.125"))
+	if !exact.Exact() {
+		t.Error("expected a terminating realized to yield an exact interval")
+	}
+}
+
+func Test_Refine(t *testing.T) {
+	original := atlas.Precision
+	defer func() { atlas.Precision = original }()
+
+	compute := func(precision uint) num.Interval {
+		atlas.Precision = precision
+		sqrt2 := num.FromPeriodicContinuedFraction(naturals(1), naturals(2))
+		sqrt3 := num.FromPeriodicContinuedFraction(naturals(1), naturals(1, 2))
+		return num.IntervalOf(&sqrt2).Mul(num.IntervalOf(&sqrt3))
+	}
+
+	result, precision := num.Refine(40, compute)
+	atlas.Precision = original
+	if p := result.Placeholders(); p < 40 {
+		t.Errorf("expected at least 40 placeholders of √6, got %d at precision %d", p, precision)
+	}
+	if !result.Contains("2.449489742783178098197284074705891391965947480656670128432692567250960377457315026539859433104640234818") {
+		t.Error("expected the result to contain √6")
+	}
+}
@@ -0,0 +1,55 @@
+package test
+
+import (
+	"core/enum/endian"
+	"core/sys/num"
+	"encoding/json"
+	"testing"
+)
+
+func measurementsEqual(a, b num.Measurement) bool {
+	return a.String() == b.String() && a.Endianness == b.Endianness
+}
+
+func Test_Measurement_Encoding_RoundTrip(t *testing.T) {
+	cases := []num.Measurement{
+		num.NewMeasurement(),
+		num.NewMeasurement(1, 0, 1),
+		num.NewMeasurementOfBytes(0x4D, 0x2C, 0x16),
+		num.NewMeasurementOfBytes(0xFF).Append(0, 1, 1),
+		num.NewMeasurementOf[uint32](5_057_558, endian.Little),
+		num.NewMeasurementOf[uint16](42, endian.BitwiseLittle),
+	}
+
+	for _, m := range cases {
+		binary, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%v) failed: %v", m, err)
+		}
+		var fromBinary num.Measurement
+		if err = fromBinary.UnmarshalBinary(binary); err != nil || !measurementsEqual(m, fromBinary) {
+			t.Errorf("binary round trip of %v (%v) = %v (%v), %v", m, m.Endianness, fromBinary, fromBinary.Endianness, err)
+		}
+
+		text, _ := m.MarshalText()
+		var fromText num.Measurement
+		if err = fromText.UnmarshalText(text); err != nil || !measurementsEqual(m, fromText) {
+			t.Errorf("text round trip of %q = %v (%v), %v", text, fromText, fromText.Endianness, err)
+		}
+
+		j, _ := json.Marshal(m)
+		var fromJSON num.Measurement
+		if err = json.Unmarshal(j, &fromJSON); err != nil || !measurementsEqual(m, fromJSON) {
+			t.Errorf("JSON round trip of %s = %v (%v), %v", j, fromJSON, fromJSON.Endianness, err)
+		}
+	}
+}
+
+func Test_Measurement_UnmarshalText_Invalid(t *testing.T) {
+	for _, s := range []string{"", "0 1 0", "| 0 2 |", "| 0 1 | Sideways"} {
+		var m num.Measurement
+		if err := m.UnmarshalText([]byte(s)); err == nil {
+			t.Errorf("UnmarshalText(%q) did not fail", s)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package test
+
+import (
+	"core/sys/num"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func Test_Natural_DivMod(t *testing.T) {
+	q, r := num.ParseNatural(1000).DivMod(num.ParseNatural(7))
+	if q.String() != "142" || r.String() != "6" {
+		t.Errorf("expected 142 r 6, got %v r %v", q, r)
+	}
+}
+
+func Test_Natural_GCD_LCM(t *testing.T) {
+	a, b := num.ParseNatural(84), num.ParseNatural(36)
+	if g := a.GCD(b); g.String() != "12" {
+		t.Errorf("expected gcd 12, got %v", g)
+	}
+	if l := a.LCM(b); l.String() != "252" {
+		t.Errorf("expected lcm 252, got %v", l)
+	}
+
+	g, x, y := a.ExtendedGCD(b)
+	if g.String() != "12" || bezout(84, x, 36, y).Int64() != 12 {
+		t.Errorf("expected 84·%v + 36·%v = 12, got %v", x, y, bezout(84, x, 36, y))
+	}
+
+	g, x, y = b.ExtendedGCD(a)
+	if g.String() != "12" || bezout(36, x, 84, y).Int64() != 12 {
+		t.Errorf("expected 36·%v + 84·%v = 12, got %v", x, y, bezout(36, x, 84, y))
+	}
+}
+
+// bezout returns 𝑎·𝑥 + 𝑏·𝑦 for the provided Bézout coefficients.
+func bezout(a int64, x num.Signed, b int64, y num.Signed) *big.Int {
+	signed := func(s num.Signed) *big.Int {
+		out, _ := new(big.Int).SetString(s.String(), 10)
+		return out
+	}
+	return new(big.Int).Add(new(big.Int).Mul(big.NewInt(a), signed(x)), new(big.Int).Mul(big.NewInt(b), signed(y)))
+}
+
+func Test_Natural_ModPow_ModInverse(t *testing.T) {
+	if p := num.ParseNatural(4).ModPow(num.ParseNatural(13), num.ParseNatural(497)); p.String() != "445" {
+		t.Errorf("expected 445, got %v", p)
+	}
+
+	inverse, ok := num.ParseNatural(3).ModInverse(num.ParseNatural(11))
+	if !ok || inverse.String() != "4" {
+		t.Errorf("expected 4, got %v (%v)", inverse, ok)
+	}
+	if _, ok = num.ParseNatural(6).ModInverse(num.ParseNatural(9)); ok {
+		t.Error("expected no inverse of 6 mod 9")
+	}
+}
+
+func Test_Natural_IsPrime(t *testing.T) {
+	primes := []string{"2", "37", "7919", "2305843009213693951", "170141183460469231731687303715884105727"}
+	for _, p := range primes {
+		if !num.ParseNatural(p).IsPrime() {
+			t.Errorf("expected %s to be prime", p)
+		}
+	}
+
+	composites := []string{"0", "1", "561", "3215031751", "2305843009213693953"}
+	for _, c := range composites {
+		if num.ParseNatural(c).IsPrime() {
+			t.Errorf("expected %s to be composite", c)
+		}
+	}
+}
+
+func Test_Natural_Factor(t *testing.T) {
+	cases := map[string]string{
+		"1":                    "",
+		"360":                  "2 2 2 3 3 5",
+		"10403":                "101 103",
+		"600851475143":         "71 839 1471 6857",
+		"18446744073709551617": "274177 67280421310721",
+	}
+	for value, expected := range cases {
+		factors := num.ParseNatural(value).Factor()
+		out := make([]string, len(factors))
+		for i, f := range factors {
+			out[i] = f.String()
+		}
+		if strings.Join(out, " ") != expected {
+			t.Errorf("expected %s to factor into [%s], got %v", value, expected, out)
+		}
+	}
+}
+
+func Test_Natural_ISqrt_Jacobi(t *testing.T) {
+	if s := num.ParseNatural(99).ISqrt(); s.String() != "9" {
+		t.Errorf("expected 9, got %v", s)
+	}
+	if j := num.ParseNatural(1001).Jacobi(num.ParseNatural(9907)); j != -1 {
+		t.Errorf("expected -1, got %d", j)
+	}
+}
+
+func Test_Natural_Theory_Wide(t *testing.T) {
+	values := []string{
+		"0", "1", "255", "256", "65535", "65537", "4294967311",
+		"18446744073709551617", "340282366920938463463374607431768211507",
+		"115792089237316195423570985008687907853269984665640564039457584007913129639935",
+	}
+	for _, av := range values {
+		a, _ := new(big.Int).SetString(av, 10)
+		na := num.ParseNatural(av)
+		if s := na.ISqrt(); s.String() != new(big.Int).Sqrt(a).String() {
+			t.Errorf("isqrt(%s) = %v", av, s)
+		}
+
+		for _, bv := range values {
+			b, _ := new(big.Int).SetString(bv, 10)
+			nb := num.ParseNatural(bv)
+			if g := na.GCD(nb); g.String() != new(big.Int).GCD(nil, nil, a, b).String() {
+				t.Errorf("gcd(%s, %s) = %v", av, bv, g)
+			}
+			if b.Sign() == 0 {
+				continue
+			}
+
+			q, r := na.DivMod(nb)
+			expectedQ, expectedR := new(big.Int).QuoRem(a, b, new(big.Int))
+			if q.String() != expectedQ.String() || r.String() != expectedR.String() {
+				t.Errorf("%s / %s = %v r %v, want %v r %v", av, bv, q, r, expectedQ, expectedR)
+			}
+			if p := na.ModPow(nb, nb); p.String() != new(big.Int).Exp(a, b, b).String() {
+				t.Errorf("%s^%s mod %s = %v", av, bv, bv, p)
+			}
+
+			inverse, ok := na.ModInverse(nb)
+			expected := new(big.Int).ModInverse(a, b)
+			if ok != (expected != nil) || (ok && inverse.String() != expected.String()) {
+				t.Errorf("inverse of %s mod %s = %v (%v), want %v", av, bv, inverse, ok, expected)
+			}
+			if b.Bit(0) == 1 {
+				if j := na.Jacobi(nb); j != big.Jacobi(a, b) {
+					t.Errorf("(%s/%s) = %d, want %d", av, bv, j, big.Jacobi(a, b))
+				}
+			}
+		}
+	}
+}
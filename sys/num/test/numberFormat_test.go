@@ -0,0 +1,95 @@
+package test
+
+import (
+	"core/enum/sign"
+	"core/sys/num"
+	"math/big"
+	"testing"
+)
+
+func Test_NumberFormat_Natural(t *testing.T) {
+	n := num.ParseNatural(1234567)
+	cases := []struct {
+		format   num.NumberFormat
+		expected string
+	}{
+		{num.FormatPlain, "1234567"},
+		{num.FormatWestern, "1,234,567"},
+		{num.FormatContinental, "1.234.567"},
+		{num.FormatIndian, "12,34,567"},
+		{num.FormatSI, "1 234 567"},
+	}
+	for _, c := range cases {
+		if out := n.PrintFormatted(c.format); out != c.expected {
+			t.Errorf("expected %s, got %s", c.expected, out)
+		}
+	}
+}
+
+func Test_NumberFormat_Realized(t *testing.T) {
+	r := num.FromRat(big.NewRat(-123456789, 100))
+	cases := []struct {
+		format   num.NumberFormat
+		width    int
+		expected string
+	}{
+		{num.FormatWestern, -1, "-1,234,567.89"},
+		{num.FormatContinental, -1, "-1.234.567,89"},
+		{num.FormatIndian, -1, "-12,34,567.89"},
+		{num.FormatSI, -1, "-1 234 567,89"},
+		{num.FormatWestern, 1, "~-1,234,567.9"},
+		{num.FormatWestern, 3, "-1,234,567.890"},
+		{num.NumberFormat{GroupSeparator: ",", GroupSizes: []uint{3}, DecimalMark: ".", Sign: sign.Accounting}, -1, "(1,234,567.89)"},
+	}
+	for _, c := range cases {
+		if out := r.PrintFormatted(c.format, c.width); out != c.expected {
+			t.Errorf("expected %s, got %s", c.expected, out)
+		}
+	}
+
+	explicit := num.NumberFormat{DecimalMark: ".", Sign: sign.Explicit}
+	third := num.FromRat(big.NewRat(1, 3))
+	if out := third.PrintFormatted(explicit, -1); out != "+0.‾3" {
+		t.Errorf("expected +0.‾3, got %s", out)
+	}
+}
+
+func Test_NumberFormat_SpacedPlaceholders(t *testing.T) {
+	n := num.ParseNatural(17 * 17 * 17 * 17)
+	if out := n.PrintFormatted(num.FormatWestern, 17); out != "01 00 , 00 00 00" {
+		t.Errorf("expected 01 00 , 00 00 00, got %s", out)
+	}
+	if back := num.FormatWestern.ParseNatural("01 00 , 00 00 00", 17); back.String() != n.String() {
+		t.Errorf("expected %s, got %s", n, back)
+	}
+}
+
+func Test_NumberFormat_Parse(t *testing.T) {
+	cases := []struct {
+		format   num.NumberFormat
+		input    string
+		expected *big.Rat
+	}{
+		{num.FormatWestern, "1,234,567.89", big.NewRat(123456789, 100)},
+		{num.FormatContinental, "-1.234.567,89", big.NewRat(-123456789, 100)},
+		{num.FormatIndian, "(12,34,567)", big.NewRat(-1234567, 1)},
+		{num.FormatSI, "+1 234 567,5", big.NewRat(2469135, 2)},
+		{num.FormatWestern, "0.‾3", big.NewRat(1, 3)},
+	}
+	for _, c := range cases {
+		r := c.format.Parse(c.input)
+		if out := num.ToRat(&r); out.Cmp(c.expected) != 0 {
+			t.Errorf("expected %s to parse as %s, got %s", c.input, c.expected.RatString(), out.RatString())
+		}
+	}
+}
+
+func Test_NumberFormat_Align(t *testing.T) {
+	out := num.FormatWestern.Align(1234.5, -12.25)
+	expected := []string{" 1,234.50", "-0,012.25"}
+	for i := range expected {
+		if out[i] != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], out[i])
+		}
+	}
+}
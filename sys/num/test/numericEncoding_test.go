@@ -0,0 +1,97 @@
+package test
+
+import (
+	"core/enum/overflow"
+	"core/sys/num"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func Test_Numeric_Text(t *testing.T) {
+	n, _ := num.NewNumericBounded(5, 0, 7, true)
+	text, _ := n.MarshalText()
+	if string(text) != "5 [0, 7] clamp" {
+		t.Fatalf("MarshalText = %q, want \"5 [0, 7] clamp\"", text)
+	}
+
+	var back num.Numeric[int]
+	if err := back.UnmarshalText([]byte("3 [-4, 9] reflect")); err != nil {
+		t.Fatal(err)
+	}
+	if back.Value() != 3 || back.Minimum() != -4 || back.Maximum() != 9 || back.Overflow != overflow.Reflect || back.Clamp {
+		t.Errorf("UnmarshalText = %v [%v, %v] %v", back.Value(), back.Minimum(), back.Maximum(), back.Overflow)
+	}
+
+	var unbounded num.Numeric[float64]
+	if err := unbounded.UnmarshalText([]byte("0.1 unbounded")); err != nil || unbounded.Value() != 0.1 {
+		t.Errorf("UnmarshalText(0.1 unbounded) = %v (%v)", unbounded.Value(), err)
+	}
+}
+
+func Test_Numeric_JSON(t *testing.T) {
+	n, _ := num.NewNumericWithPolicy[uint64](1<<63+1, 0, 1<<64-1, overflow.Panic)
+	data, err := json.Marshal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var back num.Numeric[uint64]
+	if err = json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if back.Value() != n.Value() || back.Maximum() != n.Maximum() || back.Overflow != overflow.Panic {
+		t.Errorf("round trip of %s = %v [%v, %v] %v", data, back.Value(), back.Minimum(), back.Maximum(), back.Overflow)
+	}
+
+	var c num.Numeric[complex128]
+	if err = json.Unmarshal([]byte(`{"value":"(1.5-2i)","unbounded":true}`), &c); err != nil || c.Value() != complex(1.5, -2) {
+		t.Errorf("complex decode = %v (%v)", c.Value(), err)
+	}
+}
+
+func Test_Numeric_AdvancedText(t *testing.T) {
+	var r num.Numeric[num.Realized]
+	if err := r.UnmarshalText([]byte("0.‾3 [0, 1]")); err != nil {
+		t.Fatal(err)
+	}
+
+	text, _ := r.MarshalText()
+	if string(text) != "0.‾3 [0, 1]" {
+		t.Errorf("Realized round trip = %q, want \"0.‾3 [0, 1]\"", text)
+	}
+}
+
+func Test_Numeric_JSON_UnboundedRealized(t *testing.T) {
+	var r num.Numeric[num.Realized]
+	if err := json.Unmarshal([]byte(`{"value":"0.5","unbounded":true}`), &r); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "minimum") || strings.Contains(string(data), "maximum") {
+		t.Errorf("unbounded JSON = %s, want no boundaries", data)
+	}
+}
+
+func Test_Numeric_JSON_NonFinite(t *testing.T) {
+	for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		n := num.NewNumeric(value)
+		data, err := json.Marshal(n)
+		if err != nil {
+			t.Fatalf("marshal of %v failed: %v", value, err)
+		}
+
+		var back num.Numeric[float64]
+		if err = json.Unmarshal(data, &back); err != nil {
+			t.Fatalf("unmarshal of %s failed: %v", data, err)
+		}
+		if out := back.Value(); out != value && !(math.IsNaN(out) && math.IsNaN(value)) {
+			t.Errorf("round trip of %s = %v, want %v", data, out, value)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"core/enum/change"
+	"core/sys/num"
+	"math"
+	"testing"
+)
+
+func Test_Observer_Callbacks(t *testing.T) {
+	n, _ := num.NewNumericBounded(5, 0, 7, true)
+
+	var changes []int
+	var breaches []num.Breach
+	var moved bool
+
+	n.OnChange(func(e num.Event[int]) { changes = append(changes, e.Value) })
+	n.OnBreach(func(e num.Event[int]) { breaches = append(breaches, e.Breach) })
+	n.OnBoundaries(func(e num.Event[int]) { moved = e.Minimum == 1 && e.Maximum == 3 })
+
+	n.Increment()
+	n.Increment(5)
+	n.Set(7)
+	n.SetBoundaries(1, 3)
+
+	if len(changes) != 3 || changes[0] != 6 || changes[1] != 7 || changes[2] != 3 {
+		t.Errorf("changes = %v, want [6 7 3]", changes)
+	}
+	if len(breaches) != 2 || breaches[0] != "4" || breaches[1] != "4" {
+		t.Errorf("breaches = %v, want [4 4]", breaches)
+	}
+	if !moved {
+		t.Errorf("boundary change was not observed")
+	}
+}
+
+func Test_Observer_CancelAndPotential(t *testing.T) {
+	n, _ := num.NewNumericBounded[uint](0, 0, 3)
+
+	events, subscription := n.Events(8, change.Breach)
+	breached := subscription.Potential()
+
+	n.Increment()
+	if breached() {
+		t.Errorf("potential fired without a breach")
+	}
+
+	n.Increment(5)
+	if !breached() || breached() {
+		t.Errorf("potential should latch exactly once per breach")
+	}
+	if e := <-events; e.Breach != "3" || e.Value != 2 {
+		t.Errorf("breach event = %v (%v), want 2 (3)", e.Value, e.Breach)
+	}
+
+	subscription.Cancel()
+	n.Increment(5)
+	if _, open := <-events; open || breached() {
+		t.Errorf("cancelled subscription still received events")
+	}
+}
+
+func Test_Observer_Concurrent(t *testing.T) {
+	n, _ := num.NewNumericBounded(0, 0, 100)
+	c := num.NewConcurrent(n)
+
+	var total int
+	c.Subscribe(func(e num.Event[int]) { total += e.Value - e.Previous }, change.Value)
+
+	c.Increment(3)
+	c.CompareAndSwap(0, 50)
+	c.CompareAndSwap(3, 10)
+
+	if total != 10 {
+		t.Errorf("observed delta = %v, want 10", total)
+	}
+}
+
+func Test_Observer_CopyBeforeSubscribe(t *testing.T) {
+	n, _ := num.NewNumericBounded(0, 0, 7)
+	copied := n
+
+	var changes []int
+	n.OnChange(func(e num.Event[int]) { changes = append(changes, e.Value) })
+	copied.Set(4)
+
+	if len(changes) != 1 || changes[0] != 4 {
+		t.Errorf("changes = %v, want [4]", changes)
+	}
+}
+
+func Test_Observer_NaN(t *testing.T) {
+	n := num.NewNumeric(math.NaN())
+	n.Set(math.NaN())
+
+	var changes []float64
+	n.OnChange(func(e num.Event[float64]) { changes = append(changes, e.Value) })
+
+	n.Set(math.NaN())
+	n.Set(1)
+	n.Set(math.NaN())
+
+	if len(changes) != 2 || changes[0] != 1 || !math.IsNaN(changes[1]) {
+		t.Errorf("changes = %v, want [1 NaN]", changes)
+	}
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"core/enum/overflow"
+	"core/sys/num"
+	"testing"
+)
+
+func Test_Overflow_Policies(t *testing.T) {
+	cases := map[overflow.Policy]int{
+		overflow.Wrap:    1,
+		overflow.Clamp:   7,
+		overflow.Reflect: 5,
+		overflow.Report:  9,
+	}
+
+	for policy, want := range cases {
+		n, breach := num.NewNumericWithPolicy(9, 0, 7, policy)
+		if n.Value() != want || breach != "2" {
+			t.Errorf("%v: Set(9) = %v (%v), want %v (2)", policy, n.Value(), breach, want)
+		}
+	}
+
+	n, _ := num.NewNumericWithPolicy(3, 0, 7, overflow.Reflect)
+	if breach := n.Set(-2); n.Value() != 2 || breach != "-2" {
+		t.Errorf("Reflect: Set(-2) = %v (%v), want 2 (-2)", n.Value(), breach)
+	}
+}
+
+func Test_Overflow_PingPong(t *testing.T) {
+	n, _ := num.NewNumericWithPolicy[uint](0, 0, 3, overflow.Reflect)
+
+	got := make([]uint, 0, 8)
+	for i := 0; i < 8; i++ {
+		n.Increment()
+		got = append(got, n.Value())
+	}
+
+	want := []uint{1, 2, 3, 2, 1, 0, 1, 2}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ping-pong = %v, want %v", got, want)
+		}
+	}
+}
+
+func Test_Overflow_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic when breaching a strict boundary")
+		}
+	}()
+
+	n, _ := num.NewNumericWithPolicy(5, 0, 10, overflow.Panic)
+	n.Increment(6)
+}
+
+func Test_Overflow_ClampStillHonored(t *testing.T) {
+	n, _ := num.NewNumericBounded(5, 0, 7, true)
+	if n.Increment(10); n.Value() != 7 {
+		t.Errorf("Clamp: Increment(10) = %v, want 7", n.Value())
+	}
+}
+
+func Test_Overflow_ExplicitWrapOverridesClamp(t *testing.T) {
+	n, _ := num.NewNumericBounded(5, 0, 7, true)
+	n.Overflow = overflow.Wrap
+	if n.Increment(4); n.Value() != 1 {
+		t.Errorf("Wrap: Increment(4) = %v, want 1", n.Value())
+	}
+
+	f, _ := num.NewNumericBounded(5.0, 0.0, 10.0)
+	f.Overflow = overflow.Report
+	if f.AddOrSubtract(10); f.Value() != 15 {
+		t.Errorf("Report: AddOrSubtract(10) = %v, want 15", f.Value())
+	}
+}
+
+func Test_Overflow_UnsignedUnderflow(t *testing.T) {
+	wrapped, _ := num.NewNumericBounded[uint](0, 0, 9)
+	if breach := wrapped.Decrement(); wrapped.Value() != 9 || breach != "-1" {
+		t.Errorf("Wrap: Decrement() = %v (%v), want 9 (-1)", wrapped.Value(), breach)
+	}
+
+	clamped, _ := num.NewNumericWithPolicy[uint](1, 0, 9, overflow.Clamp)
+	if clamped.Decrement(3); clamped.Value() != 0 {
+		t.Errorf("Clamp: Decrement(3) = %v, want 0", clamped.Value())
+	}
+}
@@ -0,0 +1,77 @@
+package test
+
+import (
+	"core/sys/num"
+	"math/big"
+	"testing"
+)
+
+func Test_RandomNatural_Digits(t *testing.T) {
+	for i := 0; i < 256; i++ {
+		value := num.ToRat(num.RandomNatural(3))
+		if value.Cmp(big.NewRat(100, 1)) < 0 || value.Cmp(big.NewRat(999, 1)) > 0 {
+			t.Fatalf("expected a 3 digit natural, got %v", value)
+		}
+	}
+}
+
+func Test_RandomNaturalBelow_Uniform(t *testing.T) {
+	counts := make(map[string]int)
+	for i := 0; i < 6000; i++ {
+		counts[num.RandomNaturalBelow(num.ParseNatural(6)).String()]++
+	}
+
+	if len(counts) != 6 {
+		t.Fatalf("expected all 6 values, got %v", counts)
+	}
+	for value, count := range counts {
+		if count < 800 || count > 1200 {
+			t.Errorf("expected roughly 1000 of %s, got %d", value, count)
+		}
+	}
+}
+
+func Test_RandomNaturalSetWithinRange_Exhaustion(t *testing.T) {
+	set := num.RandomNaturalSetWithinRange(12, num.ParseNatural(10), num.ParseNatural(13))
+
+	for round := 0; round < 3; round++ {
+		seen := make(map[string]struct{})
+		for _, value := range set[round*4 : round*4+4] {
+			seen[value.String()] = struct{}{}
+		}
+		if len(seen) != 4 {
+			t.Errorf("expected round %d to exhaust the range, got %v", round, set[round*4:round*4+4])
+		}
+	}
+	for i := 1; i < len(set); i++ {
+		if set[i].String() == set[i-1].String() {
+			t.Errorf("expected no repetition at the crossover, got %v", set)
+		}
+	}
+}
+
+func Test_RandomRealizedWithinRange(t *testing.T) {
+	lower := big.NewRat(-1, 2)
+	upper := big.NewRat(3, 4)
+	scale := big.NewInt(100)
+	for i := 0; i < 256; i++ {
+		r := num.RandomRealizedWithinRange(num.ParseRealized("-0.5"), num.ParseRealized("0.75"), 2)
+		value := num.ToRat(&r)
+		if value.Cmp(lower) < 0 || value.Cmp(upper) > 0 {
+			t.Fatalf("expected a value in [-0.5, 0.75], got %v", value)
+		}
+		if new(big.Int).Mod(new(big.Int).Mul(value.Num(), scale), value.Denom()).Sign() != 0 {
+			t.Fatalf("expected at most 2 placeholders, got %v", value)
+		}
+	}
+}
+
+func Test_Numeric_Random_Natural(t *testing.T) {
+	n, _ := num.NewNumericBounded(num.ParseNatural(5), num.ParseNatural(5), num.ParseNatural(9))
+	for i := 0; i < 64; i++ {
+		value := num.ToRat(n.Random())
+		if value.Cmp(big.NewRat(5, 1)) < 0 || value.Cmp(big.NewRat(9, 1)) > 0 {
+			t.Fatalf("expected a value in [5, 9], got %v", value)
+		}
+	}
+}
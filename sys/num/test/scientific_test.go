@@ -0,0 +1,77 @@
+package test
+
+import (
+	"core/sys/num"
+	"math/big"
+	"testing"
+)
+
+func Test_PrintScientific(t *testing.T) {
+	cases := []struct {
+		value       string
+		significant uint
+		base        uint16
+		expected    string
+	}{
+		{"12345", 5, 10, "1.2345e4"},
+		{"12345", 3, 10, "~1.23e4"},
+		{"-0.000123456", 4, 10, "~-1.235e-4"},
+		{"9.99", 2, 10, "~1.0e1"},
+		{"0", 3, 10, "0.00e0"},
+		{"0.125", 2, 10, "~1.3e-1"},
+		{"44", 5, 2, "1.0110×2⁵"},
+		{"0.00390625", 2, 16, "1.0×16⁻²"},
+	}
+	for _, c := range cases {
+		r := num.ParseRealized(c.value)
+		if out := r.PrintScientific(c.significant, c.base); out != c.expected {
+			t.Errorf("expected %s to print as %s, got %s", c.value, c.expected, out)
+		}
+	}
+}
+
+func Test_PrintScientific_Periodic(t *testing.T) {
+	third := num.FromRat(big.NewRat(1, 3))
+	if out := third.PrintScientific(3); out != "3.‾3e-1" {
+		t.Errorf("expected 3.‾3e-1, got %s", out)
+	}
+	if out := third.PrintScientific(1); out != "~3e-1" {
+		t.Errorf("expected ~3e-1, got %s", out)
+	}
+}
+
+func Test_PrintScientific_Irrational(t *testing.T) {
+	sqrt2 := num.FromPeriodicContinuedFraction(naturals(1), naturals(2))
+	if out := sqrt2.PrintScientific(7); out != "~1.414214e0" {
+		t.Errorf("expected ~1.414214e0, got %s", out)
+	}
+}
+
+func Test_PrintEngineering(t *testing.T) {
+	cases := []struct {
+		value       string
+		significant uint
+		expected    string
+	}{
+		{"12345", 4, "~12.35e3"},
+		{"0.00000047", 2, "470e-9"},
+		{"999999", 3, "~1.00e6"},
+		{"-1500", 2, "-1.5e3"},
+	}
+	for _, c := range cases {
+		r := num.ParseRealized(c.value)
+		if out := r.PrintEngineering(c.significant); out != c.expected {
+			t.Errorf("expected %s to print as %s, got %s", c.value, c.expected, out)
+		}
+	}
+}
+
+func Test_Natural_PrintScientific(t *testing.T) {
+	n := num.ParseNatural("100000000000000000000000000000000000000000000000000")
+	if out := n.PrintScientific(3); out != "1.00e50" {
+		t.Errorf("expected 1.00e50, got %s", out)
+	}
+	if out := n.PrintEngineering(1); out != "100e48" {
+		t.Errorf("expected 100e48, got %s", out)
+	}
+}
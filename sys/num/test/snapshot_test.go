@@ -0,0 +1,107 @@
+package test
+
+import (
+	"core/sys/num"
+	"math/big"
+	"sync"
+	"testing"
+)
+
+func Test_Realized_CopiesShareState(t *testing.T) {
+	a := num.FromRat(big.NewRat(5, 4))
+	b := a
+	b.SetNegative(true)
+
+	if !a.Negative() {
+		t.Error("expected a copied realized number to share its state")
+	}
+	if num.ToRat(&a).Cmp(big.NewRat(-5, 4)) != 0 {
+		t.Errorf("expected -5/4, got %s", num.ToRat(&a).RatString())
+	}
+}
+
+func Test_Snapshot_Immutable(t *testing.T) {
+	r := num.FromRat(big.NewRat(1, 12))
+	snap := r.Snapshot()
+
+	r.SetNegative(true)
+	whole, _, _ := snap.Digits()
+	whole[0] = 9
+
+	if snap.Negative() {
+		t.Error("expected the snapshot to keep its sign")
+	}
+	if out := num.ToRat(snap); out.Cmp(big.NewRat(1, 12)) != 0 {
+		t.Errorf("expected 1/12, got %s", out.RatString())
+	}
+	if out := snap.String(); out != "0.08‾3" {
+		t.Errorf("expected 0.08‾3, got %s", out)
+	}
+}
+
+func Test_Snapshot_Realized(t *testing.T) {
+	r := num.FromRat(big.NewRat(-7, 8), 2)
+	copied := r.Snapshot().Realized()
+	copied.SetNegative(false)
+
+	if !r.Negative() {
+		t.Error("expected a realized number created from a snapshot to be independent")
+	}
+	if copied.Base() != 2 {
+		t.Errorf("expected base 2, got %d", copied.Base())
+	}
+	if out := num.ToRat(&copied); out.Cmp(big.NewRat(7, 8)) != 0 {
+		t.Errorf("expected 7/8, got %s", out.RatString())
+	}
+}
+
+func Test_Realized_ConcurrentBase(t *testing.T) {
+	r := num.NewRealized(func(current num.Realization, base uint16, precision uint) num.Realization {
+		return current
+	}, func() bool { return true })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r.Base(uint16(2 + i))
+			r.Precision(&[]uint{uint(i)}[0])
+			r.SetNegative(i%2 == 0)
+			_ = r.Negative()
+		}(i)
+	}
+	wg.Wait()
+
+	if b := r.Base(); b < 2 || b > 9 {
+		t.Errorf("expected one of the written bases, got %d", b)
+	}
+}
+
+func Test_Snapshot_Concurrent(t *testing.T) {
+	r := num.FromRat(big.NewRat(22, 7))
+	snap := r.Snapshot()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if out := num.ToRat(snap); out.Cmp(big.NewRat(22, 7)) != 0 {
+				t.Errorf("expected 22/7, got %s", out.RatString())
+			}
+			_ = r.Snapshot()
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_Realized_Uncreated(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected an uncreated realized number to panic")
+		}
+	}()
+	var r num.Realized
+	r.Snapshot()
+}
@@ -0,0 +1,54 @@
+package test
+
+import (
+	"core/sys/num"
+	"testing"
+)
+
+func Test_Gray_RoundTrip(t *testing.T) {
+	var previous num.Measurement
+	for i := 0; i < 256; i++ {
+		m := num.NewMeasurementOfBytes(byte(i))
+		gray := m.ToGray()
+
+		if gray.FromGray().String() != m.String() {
+			t.Fatalf("%v did not survive a Gray code round trip", m.String())
+		}
+
+		if i > 0 {
+			changed := 0
+			a, b := gray.GetAllBits(), previous.GetAllBits()
+			for ii := range a {
+				if a[ii] != b[ii] {
+					changed++
+				}
+			}
+			if changed != 1 {
+				t.Fatalf("Gray codes %v and %v differ by %d bits", previous.String(), gray.String(), changed)
+			}
+		}
+		previous = gray
+	}
+}
+
+func Test_ReverseWithinBytes(t *testing.T) {
+	m := num.NewMeasurement(1, 1, 0, 1, 0, 0, 0, 0, 1, 0, 0)
+	if got := m.ReverseWithinBytes().String(); got != "00001011001" {
+		t.Errorf("ReverseWithinBytes = %v, want 00001011001", got)
+	}
+}
+
+func Test_Interleave(t *testing.T) {
+	x := num.NewMeasurement(1, 0, 1)
+	y := num.NewMeasurement(1, 1)
+
+	z := num.Interleave(x, y)
+	if z.String() != "011011" {
+		t.Errorf("Interleave = %v, want 011011", z.String())
+	}
+
+	split := num.Deinterleave(z, 2)
+	if split[0].String() != "101" || split[1].String() != "011" {
+		t.Errorf("Deinterleave = %v %v, want 101 011", split[0].String(), split[1].String())
+	}
+}
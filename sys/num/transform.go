@@ -0,0 +1,146 @@
+package num
+
+import (
+	"core/sys/support"
+	"fmt"
+)
+
+// ToGray converts the measurement from natural binary into its reflected binary Gray code, where each successive
+// value differs from the last by exactly one bit.
+//
+//	binary → 0 1 1 0 1
+//	  gray → 0 1 0 1 1   (gᵢ = bᵢ ⊕ bᵢ₋₁)
+//
+// See Measurement.ToGray and Measurement.FromGray
+func (a Measurement) ToGray() Measurement {
+	bits := a.GetAllBits()
+	out := make([]Bit, len(bits))
+	for i := range bits {
+		if i == 0 {
+			out[i] = bits[i]
+			continue
+		}
+		out[i] = bits[i] ^ bits[i-1]
+	}
+	return a.withBits(out)
+}
+
+// FromGray converts the measurement from a reflected binary Gray code back into natural binary.
+//
+//	  gray → 0 1 0 1 1
+//	binary → 0 1 1 0 1   (bᵢ = gᵢ ⊕ bᵢ₋₁)
+//
+// See Measurement.ToGray and Measurement.FromGray
+func (a Measurement) FromGray() Measurement {
+	bits := a.GetAllBits()
+	out := make([]Bit, len(bits))
+	for i := range bits {
+		if i == 0 {
+			out[i] = bits[i]
+			continue
+		}
+		out[i] = bits[i] ^ out[i-1]
+	}
+	return a.withBits(out)
+}
+
+// ReverseWithinBytes reverses the order of the bits within each measured byte, while retaining the order of the
+// bytes themselves.  If you'd like to reverse every bit of the measurement, please use Reverse.
+//
+// NOTE: If the measurement isn't byte aligned, its trailing bits are reversed amongst themselves.
+//
+//	| 1 1 0 1 0 0 0 0 - 0 0 0 0 0 0 0 1 - 1 0 0 |  ← Measurement
+//	| 0 0 0 0 1 0 1 1 - 1 0 0 0 0 0 0 0 - 0 0 1 |  ← ReverseWithinBytes
+func (a Measurement) ReverseWithinBytes() Measurement {
+	bytes := make([]byte, len(a.Bytes))
+	for i, b := range a.Bytes {
+		bytes[i] = support.ReverseByte(b)
+	}
+
+	bits := make([]Bit, len(a.Bits))
+	for i, b := range a.Bits {
+		bits[len(bits)-1-i] = b
+	}
+
+	a.Bytes = bytes
+	a.Bits = bits
+	return a
+}
+
+// Interleave creates a Morton (Z-order) code by interleaving the bits of the provided measurements.
+//
+// The first measurement occupies the least significant bit of each interleaved group, matching the conventional
+// ordering of a Morton code (where 𝑥 lands in the even bits) -
+//
+//	x → x₁ x₀
+//	y → y₁ y₀
+//	Interleave(x, y) → y₁ x₁ y₀ x₀
+//
+// NOTE: Narrower measurements are padded with leading zeros to the width of the widest, preserving their value.
+//
+// See Interleave and Deinterleave
+func Interleave(m ...Measurement) Measurement {
+	if len(m) == 0 {
+		return NewMeasurement()
+	}
+
+	width := uint(0)
+	for _, measurement := range m {
+		width = max(width, measurement.BitWidth())
+	}
+
+	padded := make([][]Bit, len(m))
+	for i, measurement := range m {
+		bits := measurement.GetAllBits()
+		padded[i] = append(make([]Bit, width-uint(len(bits))), bits...)
+	}
+
+	out := make([]Bit, 0, width*uint(len(m)))
+	for i := uint(0); i < width; i++ {
+		for j := len(m) - 1; j >= 0; j-- {
+			out = append(out, padded[j][i])
+		}
+	}
+	return NewMeasurement(out...)
+}
+
+// Deinterleave splits a Morton (Z-order) code back into its n constituent measurements.
+//
+// NOTE: The measurement's width must be a multiple of n.
+//
+// See Interleave and Deinterleave
+func Deinterleave(a Measurement, n uint) []Measurement {
+	if n == 0 {
+		panic("cannot deinterleave into zero measurements")
+	}
+
+	bits := a.GetAllBits()
+	if uint(len(bits))%n != 0 {
+		panic(fmt.Sprintf("cannot deinterleave a %d bit measurement into %d measurements", len(bits), n))
+	}
+
+	width := uint(len(bits)) / n
+	split := make([][]Bit, n)
+	for j := range split {
+		split[j] = make([]Bit, width)
+	}
+
+	for i := uint(0); i < width; i++ {
+		for j := uint(0); j < n; j++ {
+			split[n-1-j][i] = bits[i*n+j]
+		}
+	}
+
+	out := make([]Measurement, n)
+	for j := range split {
+		out[j] = NewMeasurement(split[j]...)
+	}
+	return out
+}
+
+// withBits replaces the measurement's data with the provided bits, retaining its endianness.
+func (a Measurement) withBits(bits []Bit) Measurement {
+	out := NewMeasurement(bits...)
+	out.Endianness = a.Endianness
+	return out
+}
@@ -3,6 +3,7 @@ package support
 import (
 	"core/enum/endian"
 	"encoding/binary"
+	"fmt"
 	"reflect"
 	"unsafe"
 )
@@ -10,7 +11,7 @@ import (
 // GetArchitectureEndianness returns the Endianness of the currently executing hardware.
 func GetArchitectureEndianness() endian.Endianness {
 	buf := make([]byte, 2)
-	binary.BigEndian.PutUint16(buf, 0xABCD)
+	binary.NativeEndian.PutUint16(buf, 0xABCD)
 	if buf[0] == 0xAB {
 		return endian.Big
 	}
@@ -39,6 +40,9 @@ func ReverseByte(b byte) byte {
 //
 // NOTE: Be sure to explicitly provide the type parameter to ensure Go doesn't implicitly
 // give you, say, all 8 bytes worth of an 'int' to represent a single 'byte' =)
+//
+// NOTE: The bytes are copied exactly as they are laid out in memory - meaning multi-byte values are
+// in the GetArchitectureEndianness order of the executing hardware.
 func Measure[T any](values ...T) [][]byte {
 	out := make([][]byte, len(values))
 	for i, v := range values {
@@ -94,3 +98,45 @@ func measure[T any](value T) []byte {
 	copy(bytes, (*[1 << 30]byte)(dataPtr)[:size:size])
 	return bytes
 }
+
+// Unmeasure is the inverse of Measure - it takes the "raw" memory-faithful bytes of a value and
+// reconstructs the value of the provided type from them.
+//
+// NOTE: The provided bytes must be in the GetArchitectureEndianness order of the executing hardware, and
+// there must be exactly as many bytes as the type occupies in memory.  Strings and slices are the exception,
+// as their length is derived from the number of provided bytes.
+func Unmeasure[T any](data []byte) T {
+	var zero T
+	switch any(zero).(type) {
+	case string:
+		return any(string(data)).(T)
+	}
+
+	t := reflect.TypeOf(zero)
+	if t == nil {
+		panic("cannot unmeasure into an interface type")
+	}
+
+	if t.Kind() == reflect.Slice {
+		elemSize := int(t.Elem().Size())
+		if elemSize == 0 || len(data)%elemSize != 0 {
+			panic(fmt.Sprintf("cannot unmeasure %d bytes into a %v", len(data), t))
+		}
+		out := reflect.MakeSlice(t, len(data)/elemSize, len(data)/elemSize)
+		if len(data) > 0 {
+			copy(unsafe.Slice((*byte)(out.UnsafePointer()), len(data)), data)
+		}
+		return out.Interface().(T)
+	}
+
+	size := int(t.Size())
+	if len(data) != size {
+		panic(fmt.Sprintf("cannot unmeasure %d bytes into a %d byte %v", len(data), size, t))
+	}
+
+	var out T
+	if size > 0 {
+		copy(unsafe.Slice((*byte)(unsafe.Pointer(&out)), size), data)
+	}
+	return out
+}
@@ -1,7 +1,7 @@
 package support
 
 import (
-	"math/rand/v2"
+	"core/sys/entropy"
 	"reflect"
 	"slices"
 	"strings"
@@ -29,10 +29,10 @@ func SetCase(input string, upper bool, indices ...int) string {
 	return string(runes)
 }
 
-// ShuffleSet clones and then shuffles the provided set using 'slices' and 'math/rand/v2', respectively.
+// ShuffleSet clones and then shuffles the provided set using 'slices' and the global entropy source, respectively.
 func ShuffleSet[T any](set []T) []T {
 	s := slices.Clone(set)
-	rand.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+	entropy.Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
 	return s
 }
 
@@ -112,3 +112,20 @@ func IsMultiDimensionalSlice(v any) bool {
 	d, _, ok := SliceDepth(v)
 	return ok && d >= 2
 }
+
+// PrefixFunction returns the Knuth-Morris-Pratt prefix function of the provided sequence - where each index holds the
+// length of the longest proper prefix of s[:i+1] which is also a suffix of it.
+func PrefixFunction[T comparable](s []T) []int {
+	pi := make([]int, len(s))
+	for i := 1; i < len(s); i++ {
+		j := pi[i-1]
+		for j > 0 && s[i] != s[j] {
+			j = pi[j-1]
+		}
+		if s[i] == s[j] {
+			j++
+		}
+		pi[i] = j
+	}
+	return pi
+}